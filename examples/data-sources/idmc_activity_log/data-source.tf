@@ -0,0 +1,5 @@
+# The last few runs of a task.
+data "idmc_activity_log" "example" {
+  task_id     = "0100000Z000000000002"
+  max_results = 10
+}
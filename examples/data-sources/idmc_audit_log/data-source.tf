@@ -0,0 +1,6 @@
+# Role changes made during a change window.
+data "idmc_audit_log" "example" {
+  start_time = "2024-06-01T00:00:00Z"
+  end_time   = "2024-06-02T00:00:00Z"
+  category   = "ROLE"
+}
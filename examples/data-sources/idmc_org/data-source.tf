@@ -0,0 +1,3 @@
+# The organization the provider is logged in to.
+data "idmc_org" "example" {
+}
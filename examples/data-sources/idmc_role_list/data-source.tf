@@ -1,4 +1,11 @@
-
 # Full role list
 data "idmc_role_list" "example" {
 }
+
+# Custom roles that can view delivery targets, with their privileges.
+data "idmc_role_list" "filtered" {
+  system_role        = false
+  name_regex         = "^test_"
+  has_privilege      = "view.cdmp.DeliveryTargets"
+  include_privileges = true
+}
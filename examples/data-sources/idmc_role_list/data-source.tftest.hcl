@@ -1,2 +1,13 @@
 run "data" {
+
+  assert {
+    error_message = "Privileges should only be included when asked for."
+    condition     = alltrue([for role in data.idmc_role_list.example.roles : role.privileges == null])
+  }
+
+  assert {
+    error_message = "Filtered roles should all be custom roles."
+    condition     = alltrue([for role in data.idmc_role_list.filtered.roles : !role.system_role])
+  }
+
 }
@@ -2,6 +2,12 @@ data "idmc_role_privilege_list" "example" {
   status = var.status
 }
 
+# All the read privileges of Data Integration, grouped by service.
+data "idmc_role_privilege_list" "filtered" {
+  service     = "Data Integration"
+  name_prefix = "view."
+}
+
 # Inputs
 variable "status" {
   type     = string
@@ -12,3 +18,6 @@ variable "status" {
 output "privileges" {
   value = data.idmc_role_privilege_list.example.privileges
 }
+output "privileges_by_service" {
+  value = data.idmc_role_privilege_list.filtered.privileges_by_service
+}
@@ -2,6 +2,16 @@ run "status_null" {
   variables {
     status = null
   }
+
+  assert {
+    error_message = "Filtered privileges should all have the requested prefix."
+    condition     = alltrue([for privilege in data.idmc_role_privilege_list.filtered.privileges : startswith(privilege.name, "view.")])
+  }
+
+  assert {
+    error_message = "Filtered privileges should be grouped under the one service."
+    condition     = length(data.idmc_role_privilege_list.filtered.privileges_by_service) <= 1
+  }
 }
 
 # TODO: Handle URL encoding somehow.
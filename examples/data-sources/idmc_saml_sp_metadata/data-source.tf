@@ -0,0 +1,3 @@
+# Metadata to hand to the identity provider team.
+data "idmc_saml_sp_metadata" "example" {
+}
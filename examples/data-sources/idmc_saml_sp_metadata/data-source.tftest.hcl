@@ -0,0 +1,2 @@
+run "data" {
+}
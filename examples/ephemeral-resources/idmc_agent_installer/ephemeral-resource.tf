@@ -0,0 +1,14 @@
+# Fetch an install token without it ever being written to state.
+ephemeral "idmc_agent_installer" "example" {
+  platform = "linux64"
+}
+
+# For example, to register an agent installed by a script.
+resource "terraform_data" "example" {
+  provisioner "local-exec" {
+    command = "./register-agent.sh"
+    environment = {
+      IDMC_INSTALL_TOKEN = ephemeral.idmc_agent_installer.example.install_token
+    }
+  }
+}
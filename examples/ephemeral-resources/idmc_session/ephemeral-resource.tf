@@ -0,0 +1,14 @@
+# A session for calling the IDMC apis directly, which never touches state.
+ephemeral "idmc_session" "example" {
+}
+
+# For example, from a script run by a provisioner.
+resource "terraform_data" "example" {
+  provisioner "local-exec" {
+    command = "curl -sf -H \"INFA-SESSION-ID: $IDMC_SESSION_ID\" \"$IDMC_BASE_API_URL/public/core/v3/roles\""
+    environment = {
+      IDMC_SESSION_ID   = ephemeral.idmc_session.example.session_id
+      IDMC_BASE_API_URL = ephemeral.idmc_session.example.base_api_url
+    }
+  }
+}
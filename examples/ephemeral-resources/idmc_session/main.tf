@@ -0,0 +1,13 @@
+# The correct provider source needs to be selected.
+terraform {
+  required_version = ">= 1.10"
+  required_providers {
+    idmc = {
+      source = "tzrlk/idmc"
+    }
+  }
+}
+
+# So we can configure the inputs.
+provider "idmc" {
+}
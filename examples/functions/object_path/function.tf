@@ -0,0 +1,4 @@
+# Build the path to an asset within a folder.
+locals {
+  example = provider::idmc::object_path("Default", "Shared", "Load Customers")
+}
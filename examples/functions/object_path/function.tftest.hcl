@@ -0,0 +1,7 @@
+run "function" {
+  command = plan
+  assert {
+    condition     = output.example == "Default/Shared/Load Customers"
+    error_message = "Unexpected function result."
+  }
+}
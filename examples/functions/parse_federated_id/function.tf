@@ -0,0 +1,4 @@
+# Extract the bare id from an object reference.
+locals {
+  example = provider::idmc::parse_federated_id("saas:@0123456789abcdefghijkl")
+}
@@ -0,0 +1,7 @@
+run "function" {
+  command = plan
+  assert {
+    condition     = output.example == "0123456789abcdefghijkl"
+    error_message = "Unexpected function result."
+  }
+}
@@ -0,0 +1,4 @@
+# Work out which pod an org is hosted on.
+locals {
+  example = provider::idmc::pod_from_url("https://usw3.dm-us.informaticacloud.com/saas")
+}
@@ -0,0 +1,7 @@
+run "function" {
+  command = plan
+  assert {
+    condition     = output.example == "usw3"
+    error_message = "Unexpected function result."
+  }
+}
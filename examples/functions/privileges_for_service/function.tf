@@ -0,0 +1,7 @@
+# Only the privileges relevant to data integration.
+data "idmc_role_privilege_list" "all" {
+}
+
+locals {
+  example = provider::idmc::privileges_for_service(data.idmc_role_privilege_list.all.privileges, "Data Integration")
+}
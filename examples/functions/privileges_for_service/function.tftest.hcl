@@ -0,0 +1,18 @@
+run "function" {
+  command = apply
+
+  assert {
+    condition     = length(output.example) > 0
+    error_message = "Every organization should have some Data Integration privileges."
+  }
+
+  assert {
+    condition     = alltrue([for privilege in output.example : lower(privilege.service) == "data integration"])
+    error_message = "Only Data Integration privileges should be kept."
+  }
+
+  assert {
+    condition     = length(output.example) < length(data.idmc_role_privilege_list.all.privileges)
+    error_message = "Privileges of other services should be dropped."
+  }
+}
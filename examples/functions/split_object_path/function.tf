@@ -0,0 +1,4 @@
+# Break an asset path into its project, folder, and name.
+locals {
+  example = provider::idmc::split_object_path("Default/Shared/Load Customers")
+}
@@ -0,0 +1,7 @@
+run "function" {
+  command = plan
+  assert {
+    condition     = output.example == tolist(["Default", "Shared", "Load Customers"])
+    error_message = "Unexpected function result."
+  }
+}
@@ -0,0 +1,42 @@
+# Shared tuning for every agent in the group.
+resource "idmc_agent_config" "group" {
+  runtime_environment_id = var.runtime_environment_id
+  properties = [
+    {
+      type    = "Data Integration Server"
+      subtype = "DTM"
+      name    = "JVMOption1"
+      value   = "-Xmx4096m"
+    },
+  ]
+}
+
+# A single agent, with its own overrides and service states.
+resource "idmc_agent_config" "agent" {
+  agent_id = var.agent_id
+  properties = [
+    {
+      type    = "Data Integration Server"
+      subtype = "INFO"
+      name    = "MaxDTMProcesses"
+      value   = "8"
+    },
+  ]
+  services = {
+    "Data Integration Server" = "running"
+    "Mass Ingestion"          = "stopped"
+  }
+}
+
+# Inputs
+variable "runtime_environment_id" {
+  type = string
+}
+variable "agent_id" {
+  type = string
+}
+
+# Outputs
+output "example" {
+  value = idmc_agent_config.agent
+}
@@ -0,0 +1,7 @@
+variables {
+  runtime_environment_id = "01000025000000000002"
+  agent_id               = "01000008000000000003"
+}
+
+run "create" {
+}
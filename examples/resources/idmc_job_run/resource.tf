@@ -0,0 +1,23 @@
+# Smoke test the deployment, failing the apply if the task fails.
+resource "idmc_job_run" "example" {
+  task_id   = var.task_id
+  task_type = "MTT"
+  timeout   = "15m"
+
+  triggers = {
+    deployment = var.deployment
+  }
+}
+
+# Inputs
+variable "task_id" {
+  type = string
+}
+variable "deployment" {
+  type = string
+}
+
+# Outputs
+output "example" {
+  value = idmc_job_run.example
+}
@@ -0,0 +1,25 @@
+variables {
+  task_id    = "0100000Z000000000002"
+  deployment = "1"
+}
+
+run "create" {
+
+  assert {
+    error_message = "The run should have succeeded."
+    condition     = idmc_job_run.example.status == "SUCCESS"
+  }
+
+}
+
+run "redeploy" {
+  variables {
+    deployment = "2"
+  }
+
+  assert {
+    error_message = "The task should have been run again."
+    condition     = idmc_job_run.example.run_id != run.create.example.run_id
+  }
+
+}
@@ -0,0 +1,28 @@
+resource "idmc_mapping_task" "example" {
+  name                   = var.name
+  mapping_id             = var.mapping_id
+  runtime_environment_id = idmc_runtime_environment.example.id
+  parameter_file_dir     = "/opt/infa/params"
+  parameter_file_name    = "${var.name}.param"
+  session_properties = {
+    "Pre SQL Retry Count" = "3"
+  }
+}
+
+# Somewhere for the task to run.
+resource "idmc_runtime_environment" "example" {
+  name = var.name
+}
+
+# Inputs
+variable "name" {
+  type = string
+}
+variable "mapping_id" {
+  type = string
+}
+
+# Outputs
+output "example" {
+  value = idmc_mapping_task.example
+}
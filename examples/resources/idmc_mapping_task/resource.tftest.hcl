@@ -0,0 +1,19 @@
+variables {
+  name       = "test_example"
+  mapping_id = "0100000Z000000000002"
+}
+
+run "create" {
+}
+
+run "change_name" {
+  variables {
+    name = "test_example_changed"
+  }
+
+  assert {
+    error_message = "Resource should be updated in place."
+    condition     = idmc_mapping_task.example.id == run.create.example.id
+  }
+
+}
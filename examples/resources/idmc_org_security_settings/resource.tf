@@ -0,0 +1,19 @@
+resource "idmc_org_security_settings" "example" {
+  session_timeout             = var.session_timeout
+  min_password_length         = 12
+  min_password_char_mix       = 3
+  password_reuse_in_days      = 365
+  password_expiration_in_days = 90
+  two_factor_auth             = true
+}
+
+# Inputs
+variable "session_timeout" {
+  type    = number
+  default = 30
+}
+
+# Outputs
+output "example" {
+  value = idmc_org_security_settings.example
+}
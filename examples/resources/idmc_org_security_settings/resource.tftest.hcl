@@ -0,0 +1,14 @@
+run "create" {
+}
+
+run "change_timeout" {
+  variables {
+    session_timeout = 60
+  }
+
+  assert {
+    error_message = "Session timeout should be updated."
+    condition     = idmc_org_security_settings.example.session_timeout == 60
+  }
+
+}
@@ -4,6 +4,13 @@ resource "idmc_role" "example" {
   privileges  = var.role_privileges
 }
 
+resource "idmc_role" "copy" {
+  name                = format("%s_copy", var.role_name)
+  description         = var.role_description
+  copy_from_role      = "Designer"
+  excluded_privileges = var.role_excluded_privileges
+}
+
 # Inputs
 variable "role_name" {
   type = string
@@ -14,8 +21,15 @@ variable "role_description" {
 variable "role_privileges" {
   type = list(string)
 }
+variable "role_excluded_privileges" {
+  type    = list(string)
+  default = []
+}
 
 # Outputs
 output "example" {
   value = idmc_role.example
 }
+output "copy" {
+  value = idmc_role.copy
+}
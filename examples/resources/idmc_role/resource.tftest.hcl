@@ -6,8 +6,8 @@ variables {
 #     "view.cdmp.DeliveryTargets",
     "0EBevfPsSRnjOEeM9kNvMz",
 
-#     "view.apim.apic.asset.api",
-    "03N6cwkjyQhjbVRQEwtAMJ",
+#     "03N6cwkjyQhjbVRQEwtAMJ",
+    "view.apim.apic.asset.api",
 
   ]
 }
@@ -24,6 +24,11 @@ run "create" {
     condition     = idmc_role.example.description == var.role_description
   }
 
+  assert {
+    error_message = "Privileges given by name should resolve to ids."
+    condition     = contains(idmc_role.example.privilege_ids, "03N6cwkjyQhjbVRQEwtAMJ")
+  }
+
 }
 
 run "remove_privilege" {
@@ -50,9 +55,9 @@ run "add_privilege" {
 
 }
 
-run "force_recreate" {
+run "change_description" {
   variables {
-    description = format("%s with a changed description", var.role_description)
+    role_description = format("%s with a changed description", var.role_description)
   }
 
   assert {
@@ -61,8 +66,42 @@ run "force_recreate" {
   }
 
   assert {
-    error_message = "Resource should be re-created."
-    condition     = idmc_role.example.id != run.add_privilege.example.id
+    error_message = "Resource should be updated in place."
+    condition     = idmc_role.example.id == run.add_privilege.example.id
+  }
+
+}
+
+run "rename" {
+  variables {
+    role_name = "test_role_renamed"
+  }
+
+  assert {
+    error_message = "Resulting name should have changed."
+    condition     = idmc_role.example.name == "test_role_renamed"
+  }
+
+  assert {
+    error_message = "Resource should be updated in place."
+    condition     = idmc_role.example.id == run.change_description.example.id
+  }
+
+}
+
+run "exclude_copied_privilege" {
+  variables {
+    role_excluded_privileges = [tolist(run.rename.copy.privilege_ids)[0]]
+  }
+
+  assert {
+    error_message = "Excluded privileges should not be assigned."
+    condition     = !contains(idmc_role.copy.privilege_ids, tolist(run.rename.copy.privilege_ids)[0])
+  }
+
+  assert {
+    error_message = "Resource should be updated in place."
+    condition     = idmc_role.copy.id == run.rename.copy.id
   }
 
 }
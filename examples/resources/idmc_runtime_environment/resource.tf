@@ -1,6 +1,18 @@
 resource "idmc_runtime_environment" "example" {
-  name   = var.name
-  shared = var.shared
+  name        = var.name
+  shared      = var.shared
+  description = "Managed by terraform."
+
+  # Only run what this environment needs.
+  services = ["Data Integration Server"]
+  service_properties = [
+    {
+      type    = "Data Integration Server"
+      subtype = "DTM"
+      name    = "JVMOption1"
+      value   = "-Xmx2048m"
+    },
+  ]
 }
 
 # Inputs
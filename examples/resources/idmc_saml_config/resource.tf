@@ -0,0 +1,22 @@
+resource "idmc_saml_config" "example" {
+  idp_issuer       = "https://idp.example.com/saml"
+  idp_sso_url      = "https://idp.example.com/saml/sso"
+  idp_certificate  = file(var.idp_certificate_file)
+  name_attribute   = "name"
+  email_attribute  = "email"
+  groups_attribute = "groups"
+  role_mappings = {
+    "idmc-admins"    = ["Admin"]
+    "data-engineers" = ["Designer", "Operator"]
+  }
+}
+
+# Inputs
+variable "idp_certificate_file" {
+  type = string
+}
+
+# Outputs
+output "example" {
+  value = idmc_saml_config.example
+}
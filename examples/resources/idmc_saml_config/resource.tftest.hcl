@@ -0,0 +1,6 @@
+variables {
+  idp_certificate_file = "idp.pem"
+}
+
+run "create" {
+}
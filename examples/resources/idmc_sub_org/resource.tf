@@ -0,0 +1,18 @@
+resource "idmc_sub_org" "example" {
+  name             = var.name
+  description      = "Managed by terraform."
+  inherit_licenses = true
+  country          = "NZ"
+  timezone         = "Pacific/Auckland"
+  contact_email    = "ops@example.com"
+}
+
+# Inputs
+variable "name" {
+  type = string
+}
+
+# Outputs
+output "example" {
+  value = idmc_sub_org.example
+}
@@ -0,0 +1,18 @@
+variables {
+  name = "test_example"
+}
+
+run "create" {
+}
+
+run "change_name" {
+  variables {
+    name = "test_example_changed"
+  }
+
+  assert {
+    error_message = "Resource should be updated in place."
+    condition     = idmc_sub_org.example.id == run.create.example.id
+  }
+
+}
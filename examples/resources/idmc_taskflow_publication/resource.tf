@@ -0,0 +1,23 @@
+resource "idmc_taskflow_publication" "example" {
+  taskflow_id         = var.taskflow_id
+  runtime_environment = var.runtime_environment_id
+  run_as_user         = var.run_as_user
+}
+
+# Inputs
+variable "taskflow_id" {
+  type = string
+}
+variable "runtime_environment_id" {
+  type    = string
+  default = null
+}
+variable "run_as_user" {
+  type    = string
+  default = null
+}
+
+# Outputs
+output "example" {
+  value = idmc_taskflow_publication.example
+}
@@ -0,0 +1,12 @@
+variables {
+  taskflow_id = "0123456789abcdefghijkl"
+}
+
+run "create" {
+
+  assert {
+    error_message = "Published taskflows should have a service url."
+    condition     = idmc_taskflow_publication.example.service_url != null
+  }
+
+}
@@ -0,0 +1,21 @@
+resource "idmc_trusted_ip_ranges" "example" {
+  enabled = true
+  ranges = concat(var.office_ranges, [
+    "198.51.100.7",
+    "203.0.113.10-203.0.113.20",
+  ])
+
+  # Called before applying to check the ranges include this machine's address.
+  caller_ip_url = "https://checkip.amazonaws.com"
+}
+
+# Inputs
+variable "office_ranges" {
+  type    = list(string)
+  default = ["192.0.2.0/24"]
+}
+
+# Outputs
+output "example" {
+  value = idmc_trusted_ip_ranges.example
+}
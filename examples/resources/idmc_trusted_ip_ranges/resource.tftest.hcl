@@ -0,0 +1,18 @@
+variables {
+  office_ranges = ["192.0.2.0/24"]
+}
+
+run "create" {
+}
+
+run "change_ranges" {
+  variables {
+    office_ranges = ["192.0.2.0/24", "2001:db8::/32"]
+  }
+
+  assert {
+    error_message = "CIDR blocks should be kept as written."
+    condition     = contains(idmc_trusted_ip_ranges.example.ranges, "2001:db8::/32")
+  }
+
+}
@@ -0,0 +1,75 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ApiErrorBody is implemented by the error response bodies of each api
+// version, so they can be handled as a single error type.
+type ApiErrorBody interface {
+	AsApiError() ApiError
+}
+
+// ApiError
+// A normalised form of any error response received from the IDMC apis.
+type ApiError struct {
+	Status     string
+	StatusCode int
+	Expected   []int
+	RequestId  string
+	Code       string
+	Message    string
+	Details    []ApiErrorDetail
+}
+
+type ApiErrorDetail struct {
+	Code    string
+	Message string
+}
+
+func (e *ApiError) Error() string {
+	if e.Code == "" && e.Message == "" {
+		return fmt.Sprintf("received http %s but expected %+q", e.Status, e.Expected)
+	}
+
+	var msg strings.Builder
+	msg.WriteString("received http " + e.Status + ": ")
+	if e.Code != "" {
+		msg.WriteString("[" + e.Code + "] ")
+	}
+	msg.WriteString(e.Message)
+	if e.RequestId != "" {
+		msg.WriteString(" (request " + e.RequestId + ")")
+	}
+	return msg.String()
+}
+
+// Detail renders the full error as a multi-line description, for display in
+// diagnostics.
+func (e *ApiError) Detail() string {
+	var msg strings.Builder
+	msg.WriteString("--- error ---")
+
+	msg.WriteString("\nStatus:  " + e.Status)
+	if e.RequestId != "" {
+		msg.WriteString("\nRequest: " + e.RequestId)
+	}
+	if e.Code != "" {
+		msg.WriteString("\nCode:    " + e.Code)
+	}
+	if e.Message != "" {
+		msg.WriteString("\nMsg:     " + e.Message)
+	} else {
+		msg.WriteString(fmt.Sprintf("\nMsg:     expected http %+q", e.Expected))
+	}
+	if len(e.Details) > 0 {
+		msg.WriteString("\nDetails:")
+		for _, detail := range e.Details {
+			msg.WriteString("\n  - Code: " + detail.Code)
+			msg.WriteString("\n    Msg:  " + detail.Message)
+		}
+	}
+
+	return msg.String()
+}
@@ -0,0 +1,54 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Sentinel errors that an ApiError can be classified as, for use with
+// errors.Is.
+var (
+	ErrNotFound     = errors.New("idmc object not found")
+	ErrConflict     = errors.New("idmc object conflict")
+	ErrUnauthorized = errors.New("idmc request unauthorized")
+	ErrThrottled    = errors.New("idmc request throttled")
+)
+
+// errorCodeKinds maps IDMC error codes onto sentinel errors, for the cases
+// where the http status alone doesn't give the right picture (such as the v2
+// api responding 403 for expired sessions).
+var errorCodeKinds = map[string]error{
+	"AUTH_01":  ErrUnauthorized, // v2 invalid or expired session.
+	"UI_10000": ErrUnauthorized, // v2 bad user name or password.
+	"IDS_085":  ErrUnauthorized, // v3 bad user name or password.
+}
+
+// errorStatusKinds maps http statuses onto sentinel errors.
+var errorStatusKinds = map[int]error{
+	http.StatusNotFound:        ErrNotFound,
+	http.StatusGone:            ErrNotFound,
+	http.StatusConflict:        ErrConflict,
+	http.StatusUnauthorized:    ErrUnauthorized,
+	http.StatusForbidden:       ErrUnauthorized,
+	http.StatusTooManyRequests: ErrThrottled,
+}
+
+// Kind returns the sentinel error this api error is classified as, or nil if
+// it doesn't fit any of them.
+func (e *ApiError) Kind() error {
+	if kind, ok := errorCodeKinds[e.Code]; ok {
+		return kind
+	}
+	if kind, ok := errorStatusKinds[e.StatusCode]; ok {
+		return kind
+	}
+	return nil
+}
+
+// Is allows classification of api errors via errors.Is, such as:
+//
+//	errors.Is(apiRes.RequireStatus(200), common.ErrNotFound)
+func (e *ApiError) Is(target error) bool {
+	kind := e.Kind()
+	return kind != nil && kind == target
+}
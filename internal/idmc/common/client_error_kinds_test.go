@@ -0,0 +1,31 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestApiErrorKinds(t *testing.T) {
+	RegisterTestingT(t)
+
+	notFound := &ApiError{StatusCode: http.StatusNotFound}
+	Expect(errors.Is(notFound, ErrNotFound)).To(BeTrue())
+	Expect(errors.Is(notFound, ErrConflict)).To(BeFalse())
+
+	// Classification must survive wrapping.
+	wrapped := fmt.Errorf("unable to fetch page: %w", &ApiError{StatusCode: http.StatusTooManyRequests})
+	Expect(errors.Is(wrapped, ErrThrottled)).To(BeTrue())
+
+	// Error codes take precedence over the http status.
+	expired := &ApiError{StatusCode: http.StatusInternalServerError, Code: "AUTH_01"}
+	Expect(errors.Is(expired, ErrUnauthorized)).To(BeTrue())
+
+	unknown := &ApiError{StatusCode: http.StatusBadRequest}
+	Expect(unknown.Kind()).To(BeNil())
+	Expect(errors.Is(unknown, ErrNotFound)).To(BeFalse())
+
+}
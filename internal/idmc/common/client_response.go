@@ -1,6 +1,12 @@
 package common
 
-import "net/http"
+import (
+	"fmt"
+	"net/http"
+
+	"golang.org/x/exp/slices"
+	"terraform-provider-idmc/internal/utils"
+)
 
 // ClientResponse
 // Basic details of a parsed api response.
@@ -10,11 +16,11 @@ type ClientResponse struct {
 }
 
 // IdmcClientResponse
-// Currently aspirational, but intended to be a base struct for all IDMC api
-// responses.
-type IdmcClientResponse[Dat any, Err any] struct {
+// Base struct for all IDMC api responses, holding the error bodies that every
+// operation can respond with. The success bodies are left to the embedding
+// response, since their status codes and types vary between operations.
+type IdmcClientResponse[Err ApiErrorBody] struct {
 	ClientResponse
-	JSON200 *Dat
 	JSON400 *Err
 	JSON401 *Err
 	JSON403 *Err
@@ -23,3 +29,38 @@ type IdmcClientResponse[Dat any, Err any] struct {
 	JSON502 *Err
 	JSON503 *Err
 }
+
+// ErrorBody returns whichever error body was parsed from the response, or nil
+// if there wasn't one.
+func (r IdmcClientResponse[Err]) ErrorBody() *Err {
+	return utils.Coalesce(
+		r.JSON400,
+		r.JSON401,
+		r.JSON403,
+		r.JSON404,
+		r.JSON500,
+		r.JSON502,
+		r.JSON503,
+	)
+}
+
+// RequireStatus returns nil if the response has one of the given statuses, or
+// an *ApiError describing the response otherwise.
+func (r IdmcClientResponse[Err]) RequireStatus(statuses ...int) error {
+	if r.HTTPResponse == nil {
+		return fmt.Errorf("no http response was received")
+	}
+	if slices.Contains(statuses, r.HTTPResponse.StatusCode) {
+		return nil
+	}
+
+	apiErr := &ApiError{}
+	if errBody := r.ErrorBody(); errBody != nil {
+		*apiErr = (*errBody).AsApiError()
+	}
+	apiErr.Status = r.HTTPResponse.Status
+	apiErr.StatusCode = r.HTTPResponse.StatusCode
+	apiErr.Expected = statuses
+
+	return apiErr
+}
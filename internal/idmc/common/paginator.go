@@ -0,0 +1,117 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// DefaultPageLimit is the largest page size accepted by the IDMC list apis.
+const DefaultPageLimit = 200
+
+// MaxPages is far more pages than any IDMC list should need, so reaching it
+// means the api isn't paging properly.
+const MaxPages = 10000
+
+// ErrPagingStuck is returned when an api keeps returning full pages without
+// making any progress, as would happen if it ignored the skip parameter.
+var ErrPagingStuck = errors.New("idmc api paging made no progress")
+
+// PageFetcher
+// Requests a single page of a list operation, containing no more than limit
+// items, after skipping the given number of items.
+type PageFetcher[Item any] func(ctx context.Context, limit int32, skip int32) ([]Item, error)
+
+// Paginator
+// Iterates over every page of a list operation, requesting more pages until
+// the api returns a partial one, failing with ErrPagingStuck if it stops
+// making progress. Usage follows the same pattern as
+// bufio.Scanner:
+//
+//	pages := NewPaginator(DefaultPageLimit, fetchPage)
+//	for pages.Next(ctx) {
+//		handle(pages.Page())
+//	}
+//	if err := pages.Err(); err != nil { ... }
+type Paginator[Item any] struct {
+	fetch PageFetcher[Item]
+	limit int32
+	skip  int32
+	pages int
+	page  []Item
+	done  bool
+	err   error
+}
+
+// NewPaginator creates a Paginator that will request pages of the given size
+// using the provided fetcher.
+func NewPaginator[Item any](limit int32, fetch PageFetcher[Item]) *Paginator[Item] {
+	if limit < 1 {
+		limit = DefaultPageLimit
+	}
+	return &Paginator[Item]{
+		fetch: fetch,
+		limit: limit,
+	}
+}
+
+// Next requests the next page of results, returning false once there are no
+// more pages to request, or an error has occurred.
+func (p *Paginator[Item]) Next(ctx context.Context) bool {
+	if p.done {
+		return false
+	}
+
+	page, err := p.fetch(ctx, p.limit, p.skip)
+	if err != nil {
+		p.err = fmt.Errorf("unable to fetch page at offset %d: %w", p.skip, err)
+		p.page = nil
+		p.done = true
+		return false
+	}
+
+	// Guard against apis that honour the limit but ignore the skip, which
+	// would otherwise return the same full page forever.
+	p.pages++
+	if len(page) > 0 && len(p.page) > 0 && reflect.DeepEqual(page[0], p.page[0]) {
+		p.err = fmt.Errorf("page at offset %d repeats the previous page: %w", p.skip, ErrPagingStuck)
+	} else if p.pages > MaxPages {
+		p.err = fmt.Errorf("more than %d pages requested: %w", MaxPages, ErrPagingStuck)
+	}
+	if p.err != nil {
+		p.page = nil
+		p.done = true
+		return false
+	}
+
+	// Anything short of a full page means we've reached the end. Anything
+	// larger than a full page means the api isn't paging at all, so there's
+	// nothing further to request either.
+	if len(page) != int(p.limit) {
+		p.done = true
+	}
+
+	p.page = page
+	p.skip += int32(len(page))
+	return len(page) > 0
+}
+
+// Page returns the items retrieved by the most recent call to Next.
+func (p *Paginator[Item]) Page() []Item {
+	return p.page
+}
+
+// Err returns the first error encountered while fetching pages, if any.
+func (p *Paginator[Item]) Err() error {
+	return p.err
+}
+
+// All drains the paginator, returning every item from every remaining page.
+func (p *Paginator[Item]) All(ctx context.Context) ([]Item, error) {
+	items := make([]Item, 0, p.limit)
+	for p.Next(ctx) {
+		items = append(items, p.Page()...)
+	}
+	return items, p.Err()
+}
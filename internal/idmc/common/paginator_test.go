@@ -0,0 +1,97 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func fakePages(total int, calls *[]int32) PageFetcher[int] {
+	return func(ctx context.Context, limit int32, skip int32) ([]int, error) {
+		*calls = append(*calls, skip)
+		page := make([]int, 0, limit)
+		for item := int(skip); item < total && len(page) < int(limit); item++ {
+			page = append(page, item)
+		}
+		return page, nil
+	}
+}
+
+func TestPaginatorAll(t *testing.T) {
+	RegisterTestingT(t)
+
+	var calls []int32
+	items, err := NewPaginator(10, fakePages(25, &calls)).All(context.TODO())
+
+	Expect(err).To(BeNil())
+	Expect(items).To(HaveLen(25))
+	Expect(items[24]).To(Equal(24))
+	Expect(calls).To(Equal([]int32{0, 10, 20}))
+
+}
+
+func TestPaginatorExactPages(t *testing.T) {
+	RegisterTestingT(t)
+
+	// A final empty page is needed to know there's nothing left.
+	var calls []int32
+	items, err := NewPaginator(10, fakePages(20, &calls)).All(context.TODO())
+
+	Expect(err).To(BeNil())
+	Expect(items).To(HaveLen(20))
+	Expect(calls).To(Equal([]int32{0, 10, 20}))
+
+}
+
+func TestPaginatorUnpaged(t *testing.T) {
+	RegisterTestingT(t)
+
+	// Apis that ignore the limit should only be called once.
+	var calls []int32
+	items, err := NewPaginator(10, func(ctx context.Context, limit int32, skip int32) ([]int, error) {
+		calls = append(calls, skip)
+		return make([]int, 15), nil
+	}).All(context.TODO())
+
+	Expect(err).To(BeNil())
+	Expect(items).To(HaveLen(15))
+	Expect(calls).To(HaveLen(1))
+
+}
+
+func TestPaginatorError(t *testing.T) {
+	RegisterTestingT(t)
+
+	var calls []int32
+	fetch := fakePages(25, &calls)
+	pages := NewPaginator(10, func(ctx context.Context, limit int32, skip int32) ([]int, error) {
+		if skip >= 10 {
+			return nil, fmt.Errorf("boom")
+		}
+		return fetch(ctx, limit, skip)
+	})
+
+	Expect(pages.Next(context.TODO())).To(BeTrue())
+	Expect(pages.Page()).To(HaveLen(10))
+	Expect(pages.Next(context.TODO())).To(BeFalse())
+	Expect(pages.Err()).To(MatchError(ContainSubstring("boom")))
+	Expect(pages.Next(context.TODO())).To(BeFalse())
+
+}
+
+func TestPaginatorIgnoredSkip(t *testing.T) {
+	RegisterTestingT(t)
+
+	// Apis that ignore the skip would otherwise be paged through forever.
+	var calls []int32
+	_, err := NewPaginator(10, func(ctx context.Context, limit int32, skip int32) ([]int, error) {
+		calls = append(calls, skip)
+		return fakePages(100, new([]int32))(ctx, limit, 0)
+	}).All(context.TODO())
+
+	Expect(err).To(MatchError(ErrPagingStuck))
+	Expect(calls).To(Equal([]int32{0, 10}))
+
+}
@@ -0,0 +1,44 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DefaultPollInterval is how long to wait between status checks on jobs that
+// the IDMC apis run asynchronously.
+const DefaultPollInterval = 2 * time.Second
+
+// PollCheck
+// Checks on the progress of an asynchronous job, returning true once it has
+// finished, or an error if it has failed (or can't be checked).
+type PollCheck func(ctx context.Context) (bool, error)
+
+// Poll runs the check immediately, then again every interval, until the check
+// reports that it's done, fails, or the context is cancelled. Deadlines should
+// be applied through the context.
+func Poll(ctx context.Context, interval time.Duration, check PollCheck) error {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for attempt := 1; ; attempt++ {
+		done, err := check(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("gave up waiting after %d checks: %w", attempt, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
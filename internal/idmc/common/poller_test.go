@@ -0,0 +1,52 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPollDone(t *testing.T) {
+	RegisterTestingT(t)
+
+	checks := 0
+	err := Poll(context.TODO(), time.Millisecond, func(ctx context.Context) (bool, error) {
+		checks++
+		return checks == 3, nil
+	})
+
+	Expect(err).To(BeNil())
+	Expect(checks).To(Equal(3))
+
+}
+
+func TestPollError(t *testing.T) {
+	RegisterTestingT(t)
+
+	checks := 0
+	err := Poll(context.TODO(), time.Millisecond, func(ctx context.Context) (bool, error) {
+		checks++
+		return false, fmt.Errorf("boom")
+	})
+
+	Expect(err).To(MatchError("boom"))
+	Expect(checks).To(Equal(1))
+
+}
+
+func TestPollTimeout(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 20*time.Millisecond)
+	defer cancel()
+
+	err := Poll(ctx, time.Millisecond, func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+
+	Expect(err).To(MatchError(context.DeadlineExceeded))
+
+}
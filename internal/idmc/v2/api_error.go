@@ -0,0 +1,36 @@
+package v2
+
+import (
+	"terraform-provider-idmc/internal/idmc/common"
+)
+
+var _ common.ApiErrorBody = ApiErrorResponse{}
+
+func (b ApiErrorResponse) AsApiError() common.ApiError {
+
+	// Try handling the error as a v2 error first.
+	if v2Error, err := b.AsApiErrorResponseBody(); err == nil {
+		if v2Error.Type == ApiErrorResponseBodyTypeError {
+			return common.ApiError{
+				Code:    v2Error.Code,
+				Message: v2Error.Description,
+			}
+		}
+	}
+
+	// Then try handling it as a v3 formatted error.
+	if v3Error, err := b.AsExternalRef1ApiErrorResponseBody(); err == nil && v3Error.Error.Code != "" {
+		return v3Error.AsApiError()
+	}
+
+	// If neither work, just yeet the body in as a string.
+	if jsonError, err := b.MarshalJSON(); err == nil {
+		return common.ApiError{
+			Message: string(jsonError),
+		}
+	}
+
+	return common.ApiError{
+		Message: "FAILED TO PARSE",
+	}
+}
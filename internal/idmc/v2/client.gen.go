@@ -24,21 +24,56 @@ import (
 // </editor-fold> //////////////////////////////////////////////////////////////
 // <editor-fold desc="constants" defaultstate="collapsed"> /////////////////////
 
+// Defines values for AgentType.
+const (
+	AgentTypeAgent AgentType = "agent"
+)
+
 // Defines values for ApiErrorResponseBodyType.
 const (
 	ApiErrorResponseBodyTypeError ApiErrorResponseBodyType = "error"
 )
 
+// Defines values for CreateSubOrgRequestBodyType.
+const (
+	CreateSubOrgRequestBodyTypeOrg CreateSubOrgRequestBodyType = "org"
+)
+
 // Defines values for GetAgentInstallerInfoResponseBodyType.
 const (
 	GetAgentInstallerInfoResponseBodyTypeAgentInstallerInfo GetAgentInstallerInfoResponseBodyType = "agentInstallerInfo"
 )
 
+// Defines values for JobRequestBodyType.
+const (
+	JobRequestBodyTypeJob JobRequestBodyType = "job"
+)
+
 // Defines values for LoginRequestBodyType.
 const (
 	LoginRequestBodyTypeLogin LoginRequestBodyType = "login"
 )
 
+// Defines values for MappingTaskType.
+const (
+	MappingTaskTypeMtTask MappingTaskType = "mtTask"
+)
+
+// Defines values for MappingTaskDataType.
+const (
+	MappingTaskDataTypeMtTask MappingTaskDataType = "mtTask"
+)
+
+// Defines values for OrgType.
+const (
+	OrgTypeOrg OrgType = "org"
+)
+
+// Defines values for OrgDataType.
+const (
+	OrgDataTypeOrg OrgDataType = "org"
+)
+
 // Defines values for RuntimeEnvironmentType.
 const (
 	RuntimeEnvironmentTypeRuntimeEnvironment RuntimeEnvironmentType = "runtimeEnvironment"
@@ -49,13 +84,171 @@ const (
 	RuntimeEnvironmentDataMinimalTypeRuntimeEnvironment RuntimeEnvironmentDataMinimalType = "runtimeEnvironment"
 )
 
+// Defines values for TaskType.
+const (
+	TaskTypeDMASK    TaskType = "DMASK"
+	TaskTypeDRS      TaskType = "DRS"
+	TaskTypeDSS      TaskType = "DSS"
+	TaskTypeMTT      TaskType = "MTT"
+	TaskTypePCS      TaskType = "PCS"
+	TaskTypeTASKFLOW TaskType = "TASKFLOW"
+	TaskTypeWORKFLOW TaskType = "WORKFLOW"
+)
+
+// Defines values for UpdateAgentRequestBodyType.
+const (
+	UpdateAgentRequestBodyTypeAgent UpdateAgentRequestBodyType = "agent"
+)
+
 // Defines values for UpdateRuntimeEnvironmentRequestBodyType.
 const (
 	UpdateRuntimeEnvironmentRequestBodyTypeRuntimeEnvironment UpdateRuntimeEnvironmentRequestBodyType = "runtimeEnvironment"
 )
 
+// Defines values for UpdateMappingTaskParamsUpdateMode.
+const (
+	UpdateMappingTaskParamsUpdateModeFULL    UpdateMappingTaskParamsUpdateMode = "FULL"
+	UpdateMappingTaskParamsUpdateModePARTIAL UpdateMappingTaskParamsUpdateMode = "PARTIAL"
+)
+
 // </editor-fold> //////////////////////////////////////////////////////////////
 
+// ActivityLogEntry defines model for activityLogEntry.
+type ActivityLogEntry struct {
+	// EndTime When the task finished.
+	EndTime *string `json:"endTime,omitempty"`
+
+	// ErrorMsg Error message, if the task failed.
+	ErrorMsg *string `json:"errorMsg,omitempty"`
+
+	// FailedSourceRows Number of rows that couldn't be read from the source.
+	FailedSourceRows *int64 `json:"failedSourceRows,omitempty"`
+
+	// FailedTargetRows Number of rows that couldn't be written to the target.
+	FailedTargetRows *int64 `json:"failedTargetRows,omitempty"`
+
+	// Id Activity log entry ID.
+	Id *string `json:"id,omitempty"`
+
+	// ObjectId ID of the task that ran.
+	ObjectId *string `json:"objectId,omitempty"`
+
+	// ObjectName Name of the task that ran.
+	ObjectName *string `json:"objectName,omitempty"`
+
+	// RunId ID of the run of the task.
+	RunId *int64 `json:"runId,omitempty"`
+
+	// RuntimeEnvironmentId ID of the runtime environment the task ran on.
+	RuntimeEnvironmentId *string `json:"runtimeEnvironmentId,omitempty"`
+
+	// StartTime When the task started.
+	StartTime *string `json:"startTime,omitempty"`
+
+	// StartedBy User or schedule that started the task.
+	StartedBy *string `json:"startedBy,omitempty"`
+
+	// State Outcome of the task:
+	// * 1: Success.
+	// * 2: Warning, such as some rows being rejected.
+	// * 3: Failure.
+	State *int `json:"state,omitempty"`
+
+	// SuccessSourceRows Number of rows successfully read from the source.
+	SuccessSourceRows *int64 `json:"successSourceRows,omitempty"`
+
+	// SuccessTargetRows Number of rows successfully written to the target.
+	SuccessTargetRows *int64 `json:"successTargetRows,omitempty"`
+
+	// Type Type of the entry, such as 'MTT_ACTIVITY_LOG'.
+	Type *string `json:"type,omitempty"`
+}
+
+// Agent defines model for agent.
+type Agent struct {
+	Type *AgentType `json:"@type,omitempty"`
+
+	// Active Whether the Secure Agent is running.
+	Active *bool `json:"active,omitempty"`
+
+	// AgentConfigs Configuration properties of the Secure Agent.
+	AgentConfigs *[]AgentConfig `json:"agentConfigs,omitempty"`
+
+	// Id Secure Agent ID.
+	Id *string `json:"id,omitempty"`
+
+	// Name Secure Agent name.
+	Name *string `json:"name,omitempty"`
+
+	// OrgId Organization ID.
+	OrgId *string `json:"orgId,omitempty"`
+
+	// Platform Platform the Secure Agent runs on, such as 'linux64' or 'win64'.
+	Platform *string `json:"platform,omitempty"`
+
+	// RuntimeEnvironmentId ID of the Secure Agent group the agent belongs to.
+	RuntimeEnvironmentId *string `json:"runtimeEnvironmentId,omitempty"`
+}
+
+// AgentType defines model for Agent.Type.
+type AgentType string
+
+// AgentConfig defines model for agentConfig.
+type AgentConfig struct {
+	// Customized Whether the value has been changed from its default.
+	Customized *bool `json:"customized,omitempty"`
+
+	// DefaultValue Value of the property when not customized.
+	DefaultValue *string `json:"defaultValue,omitempty"`
+
+	// Name Name of the property.
+	Name string `json:"name"`
+
+	// Platform Platform the property applies to, if it's platform-specific.
+	Platform *string `json:"platform,omitempty"`
+
+	// Subtype Category of the property within the service, such as 'DTM' or 'INFO'.
+	Subtype *string `json:"subtype,omitempty"`
+
+	// Type Service the property applies to, such as 'Data Integration Server'.
+	Type string `json:"type"`
+
+	// Value Value of the property.
+	Value *string `json:"value,omitempty"`
+}
+
+// AgentConfigList defines model for agentConfigList.
+type AgentConfigList struct {
+	// AgentConfigs Configuration properties of the Secure Agent group.
+	AgentConfigs []AgentConfig `json:"agentConfigs"`
+}
+
+// AgentDetails defines model for agentDetails.
+type AgentDetails struct {
+	// AgentEngines Services installed on the Secure Agent.
+	AgentEngines *[]AgentEngine `json:"agentEngines,omitempty"`
+
+	// Id Secure Agent ID.
+	Id *string `json:"id,omitempty"`
+
+	// Name Secure Agent name.
+	Name *string `json:"name,omitempty"`
+}
+
+// AgentEngine defines model for agentEngine.
+type AgentEngine struct {
+	AgentEngineStatus *struct {
+		// AppDisplayName Display name of the service.
+		AppDisplayName *string `json:"appDisplayName,omitempty"`
+
+		// Appname Name of the service, such as 'Data Integration Server'.
+		Appname *string `json:"appname,omitempty"`
+
+		// Status Status of the service, such as 'RUNNING' or 'STOPPED'.
+		Status *string `json:"status,omitempty"`
+	} `json:"agentEngineStatus,omitempty"`
+}
+
 // ApiErrorResponse defines model for apiErrorResponse.
 type ApiErrorResponse struct {
 	union json.RawMessage
@@ -78,6 +271,107 @@ type ApiErrorResponseBody struct {
 // ApiErrorResponseBodyType defines model for ApiErrorResponseBody.Type.
 type ApiErrorResponseBodyType string
 
+// AuditLogEntry defines model for auditLogEntry.
+type AuditLogEntry struct {
+	// Category Category of the object, such as 'USER', 'ROLE', or 'MTTASK'.
+	Category *string `json:"category,omitempty"`
+
+	// EntryTime When the action was performed.
+	EntryTime *string `json:"entryTime,omitempty"`
+
+	// Event The action performed, such as 'CREATE', 'UPDATE', or 'DELETE'.
+	Event *string `json:"event,omitempty"`
+
+	// EventParam Details of the action.
+	EventParam *string `json:"eventParam,omitempty"`
+
+	// Id Audit log entry ID.
+	Id *string `json:"id,omitempty"`
+
+	// Message Description of the action.
+	Message *string `json:"message,omitempty"`
+
+	// ObjectId ID of the object the action was performed on.
+	ObjectId *string `json:"objectId,omitempty"`
+
+	// ObjectName Name of the object the action was performed on.
+	ObjectName *string `json:"objectName,omitempty"`
+
+	// OrgId Organization ID.
+	OrgId *string `json:"orgId,omitempty"`
+
+	// Username User who performed the action.
+	Username *string `json:"username,omitempty"`
+}
+
+// CreateSubOrgRequestBody defines model for createSubOrgRequestBody.
+type CreateSubOrgRequestBody struct {
+	Type *CreateSubOrgRequestBodyType `json:"@type,omitempty"`
+
+	// Address1 First line of the organization's address.
+	Address1 *string `json:"address1,omitempty"`
+
+	// Address2 Second line of the organization's address.
+	Address2 *string `json:"address2,omitempty"`
+
+	// City City of the organization's address.
+	City *string `json:"city,omitempty"`
+
+	// ContactEmail Email address of the organization's contact.
+	ContactEmail *string `json:"contactEmail,omitempty"`
+
+	// ContactFirstName First name of the organization's contact.
+	ContactFirstName *string `json:"contactFirstName,omitempty"`
+
+	// ContactLastName Last name of the organization's contact.
+	ContactLastName *string `json:"contactLastName,omitempty"`
+
+	// ContactPhone Phone number of the organization's contact.
+	ContactPhone *string `json:"contactPhone,omitempty"`
+
+	// Country Country of the organization's address.
+	Country *string `json:"country,omitempty"`
+
+	// Description Description of the organization.
+	Description *string `json:"description,omitempty"`
+
+	// EnableTwoFactorAuth Whether users must use two-factor authentication to log in.
+	EnableTwoFactorAuth *bool `json:"enableTwoFactorAuth,omitempty"`
+
+	// InheritLicenses Whether the sub-organization inherits the licenses of its parent, rather than having its own assigned.
+	InheritLicenses *bool `json:"inheritLicenses,omitempty"`
+
+	// MinPasswordCharMix Number of character classes (lowercase, uppercase, numeric, special) a user password must mix.
+	MinPasswordCharMix *int64 `json:"minPasswordCharMix,omitempty"`
+
+	// MinPasswordLength Minimum number of characters a user password must contain.
+	MinPasswordLength *int64 `json:"minPasswordLength,omitempty"`
+
+	// Name Name of the organization.
+	Name string `json:"name"`
+
+	// PasswordExpirationInDays Number of days until a user password expires.
+	PasswordExpirationInDays *int64 `json:"passwordExpirationInDays,omitempty"`
+
+	// PasswordReuseInDays Number of days before a user can reuse a previous password.
+	PasswordReuseInDays *int64 `json:"passwordReuseInDays,omitempty"`
+
+	// SessionTimeout Number of minutes a session can be idle before it times out.
+	SessionTimeout *int64 `json:"sessionTimeout,omitempty"`
+
+	// State State of the organization's address.
+	State *string `json:"state,omitempty"`
+
+	// Timezone Default time zone of the organization.
+	Timezone *string `json:"timezone,omitempty"`
+
+	// Zipcode Postal code of the organization's address.
+	Zipcode *string `json:"zipcode,omitempty"`
+}
+
+// CreateSubOrgRequestBodyType defines model for CreateSubOrgRequestBody.Type.
+type CreateSubOrgRequestBodyType string
+
 // GetAgentInstallerInfoResponseBody defines model for getAgentInstallerInfoResponseBody.
 type GetAgentInstallerInfoResponseBody struct {
 	Type *GetAgentInstallerInfoResponseBodyType `json:"@type,omitempty"`
@@ -95,6 +389,55 @@ type GetAgentInstallerInfoResponseBody struct {
 // GetAgentInstallerInfoResponseBodyType defines model for GetAgentInstallerInfoResponseBody.Type.
 type GetAgentInstallerInfoResponseBodyType string
 
+// JobRequestBody defines model for jobRequestBody.
+type JobRequestBody struct {
+	Type *JobRequestBodyType `json:"@type,omitempty"`
+
+	// TaskFederatedId Global unique identifier of the task to start.
+	TaskFederatedId *string `json:"taskFederatedId,omitempty"`
+
+	// TaskId ID of the task to start. Use taskFederatedId for taskflows.
+	TaskId *string `json:"taskId,omitempty"`
+
+	// TaskName Name of the task to start, if the id isn't known.
+	TaskName *string `json:"taskName,omitempty"`
+
+	// TaskType The type of task:
+	// * DMASK: Masking task.
+	// * DRS: Replication task.
+	// * DSS: Synchronization task.
+	// * MTT: Mapping task.
+	// * PCS: PowerCenter task.
+	// * WORKFLOW: Linear taskflow.
+	// * TASKFLOW: Taskflow.
+	TaskType TaskType `json:"taskType"`
+}
+
+// JobRequestBodyType defines model for JobRequestBody.Type.
+type JobRequestBodyType string
+
+// JobResponseBody defines model for jobResponseBody.
+type JobResponseBody struct {
+	// RunId ID of this run of the task, for finding it in the activity log.
+	RunId *int64 `json:"runId,omitempty"`
+
+	// TaskId ID of the started task.
+	TaskId *string `json:"taskId,omitempty"`
+
+	// TaskName Name of the started task.
+	TaskName *string `json:"taskName,omitempty"`
+
+	// TaskType The type of task:
+	// * DMASK: Masking task.
+	// * DRS: Replication task.
+	// * DSS: Synchronization task.
+	// * MTT: Mapping task.
+	// * PCS: PowerCenter task.
+	// * WORKFLOW: Linear taskflow.
+	// * TASKFLOW: Taskflow.
+	TaskType *TaskType `json:"taskType,omitempty"`
+}
+
 // LoginRequestBody defines model for loginRequestBody.
 type LoginRequestBody struct {
 	Type *LoginRequestBodyType `json:"@type,omitempty"`
@@ -211,1088 +554,5108 @@ type LoginResponseBody struct {
 	UuId *string `json:"uuId,omitempty"`
 }
 
-// RuntimeEnvironment defines model for runtimeEnvironment.
-type RuntimeEnvironment struct {
-	Type *RuntimeEnvironmentType `json:"@type,omitempty"`
-
-	// Agents Agents assigned to the Secure Agent group.
-	Agents *[]RuntimeEnvironmentAgent `json:"agents,omitempty"`
+// MappingTask defines model for mappingTask.
+type MappingTask struct {
+	Type *MappingTaskType `json:"@type,omitempty"`
 
-	// CreateTime Date and time the Secure Agent group was created.
+	// CreateTime Date and time the mapping task was created.
 	CreateTime *string `json:"createTime,omitempty"`
 
-	// CreatedBy User who created the Secure Agent group.
+	// CreatedBy User who created the mapping task.
 	CreatedBy *string `json:"createdBy,omitempty"`
 
-	// Description Description of the Secure Agent group.
+	// Description Description of the mapping task.
 	Description *string `json:"description,omitempty"`
 
-	// FederatedId Global unique identifier.
-	FederatedId *string `json:"federatedId,omitempty"`
+	// FrsGuid Global unique identifier.
+	FrsGuid *string `json:"frsGuid,omitempty"`
 
-	// Id Secure Agent group ID.
+	// Id Mapping task ID.
 	Id *string `json:"id,omitempty"`
 
-	// IsShared Whether the Secure Agent group can be shared with sub-organizations.
-	IsShared *bool `json:"isShared,omitempty"`
+	// MappingId ID of the mapping the task runs.
+	MappingId string `json:"mappingId"`
 
-	// Name Secure Agent group name.
+	// Name Name of the mapping task.
 	Name string `json:"name"`
 
 	// OrgId Organization ID.
 	OrgId *string `json:"orgId,omitempty"`
 
-	// ServerlessConfig Attribute that defines serverless runtime environment properties.
-	ServerlessConfig *map[string]interface{} `json:"serverlessConfig,omitempty"`
+	// ParameterFileDir Path of the directory containing the parameter file, on the Secure Agent machine.
+	ParameterFileDir *string `json:"parameterFileDir,omitempty"`
 
-	// UpdateTime Date and time that the Secure Agent group was last updated.
+	// ParameterFileName Name of the parameter file.
+	ParameterFileName *string `json:"parameterFileName,omitempty"`
+
+	// PostProcessingCmd Command to run after the task.
+	PostProcessingCmd *string `json:"postProcessingCmd,omitempty"`
+
+	// PreProcessingCmd Command to run before the task.
+	PreProcessingCmd *string `json:"preProcessingCmd,omitempty"`
+
+	// RuntimeEnvironmentId ID of the runtime environment the task runs on.
+	RuntimeEnvironmentId string `json:"runtimeEnvironmentId"`
+
+	// ScheduleId ID of the schedule that runs the task, if any.
+	ScheduleId *string `json:"scheduleId,omitempty"`
+
+	// SessionProperties Advanced session properties, keyed by property name.
+	SessionProperties *map[string]string `json:"sessionProperties,omitempty"`
+
+	// UpdateTime Date and time the mapping task was last updated.
 	UpdateTime *string `json:"updateTime,omitempty"`
 
-	// UpdatedBy User who last updated the Secure Agent group.
+	// UpdatedBy User who last updated the mapping task.
 	UpdatedBy *string `json:"updatedBy,omitempty"`
 }
 
-// RuntimeEnvironmentType defines model for RuntimeEnvironment.Type.
-type RuntimeEnvironmentType string
+// MappingTaskType defines model for MappingTask.Type.
+type MappingTaskType string
 
-// RuntimeEnvironmentAgent An agent assigned to a Secure Agent group.
-type RuntimeEnvironmentAgent struct {
-	// Id Agent ID.
-	Id *string `json:"id,omitempty"`
+// MappingTaskData defines model for mappingTaskData.
+type MappingTaskData struct {
+	Type *MappingTaskDataType `json:"@type,omitempty"`
 
-	// OrgId Organization ID.
-	OrgId *string `json:"orgId,omitempty"`
+	// Description Description of the mapping task.
+	Description *string `json:"description,omitempty"`
+
+	// MappingId ID of the mapping the task runs.
+	MappingId string `json:"mappingId"`
+
+	// Name Name of the mapping task.
+	Name string `json:"name"`
+
+	// ParameterFileDir Path of the directory containing the parameter file, on the Secure Agent machine.
+	ParameterFileDir *string `json:"parameterFileDir,omitempty"`
+
+	// ParameterFileName Name of the parameter file.
+	ParameterFileName *string `json:"parameterFileName,omitempty"`
+
+	// PostProcessingCmd Command to run after the task.
+	PostProcessingCmd *string `json:"postProcessingCmd,omitempty"`
+
+	// PreProcessingCmd Command to run before the task.
+	PreProcessingCmd *string `json:"preProcessingCmd,omitempty"`
+
+	// RuntimeEnvironmentId ID of the runtime environment the task runs on.
+	RuntimeEnvironmentId string `json:"runtimeEnvironmentId"`
+
+	// ScheduleId ID of the schedule that runs the task, if any.
+	ScheduleId *string `json:"scheduleId,omitempty"`
+
+	// SessionProperties Advanced session properties, keyed by property name.
+	SessionProperties *map[string]string `json:"sessionProperties,omitempty"`
 }
 
-// RuntimeEnvironmentDataBulk defines model for runtimeEnvironmentDataBulk.
-type RuntimeEnvironmentDataBulk struct {
-	// Agents Agents assigned to the Secure Agent group.
-	Agents *[]RuntimeEnvironmentAgent `json:"agents,omitempty"`
+// MappingTaskDataType defines model for MappingTaskData.Type.
+type MappingTaskDataType string
 
-	// CreateTime Date and time the Secure Agent group was created.
+// MappingTaskDataBulk defines model for mappingTaskDataBulk.
+type MappingTaskDataBulk struct {
+	// CreateTime Date and time the mapping task was created.
 	CreateTime *string `json:"createTime,omitempty"`
 
-	// CreatedBy User who created the Secure Agent group.
+	// CreatedBy User who created the mapping task.
 	CreatedBy *string `json:"createdBy,omitempty"`
 
-	// Description Description of the Secure Agent group.
-	Description *string `json:"description,omitempty"`
-
-	// FederatedId Global unique identifier.
-	FederatedId *string `json:"federatedId,omitempty"`
+	// FrsGuid Global unique identifier.
+	FrsGuid *string `json:"frsGuid,omitempty"`
 
-	// Id Secure Agent group ID.
+	// Id Mapping task ID.
 	Id *string `json:"id,omitempty"`
 
-	// Name Secure Agent group name.
-	Name *string `json:"name,omitempty"`
-
 	// OrgId Organization ID.
 	OrgId *string `json:"orgId,omitempty"`
 
-	// ServerlessConfig Attribute that defines serverless runtime environment properties.
-	ServerlessConfig *map[string]interface{} `json:"serverlessConfig,omitempty"`
-
-	// UpdateTime Date and time that the Secure Agent group was last updated.
+	// UpdateTime Date and time the mapping task was last updated.
 	UpdateTime *string `json:"updateTime,omitempty"`
 
-	// UpdatedBy User who last updated the Secure Agent group.
+	// UpdatedBy User who last updated the mapping task.
 	UpdatedBy *string `json:"updatedBy,omitempty"`
 }
 
-// RuntimeEnvironmentDataMinimal defines model for runtimeEnvironmentDataMinimal.
-type RuntimeEnvironmentDataMinimal struct {
-	Type *RuntimeEnvironmentDataMinimalType `json:"@type,omitempty"`
+// Org defines model for org.
+type Org struct {
+	Type *OrgType `json:"@type,omitempty"`
 
-	// IsShared Whether the Secure Agent group can be shared with sub-organizations.
-	IsShared *bool `json:"isShared,omitempty"`
+	// Address1 First line of the organization's address.
+	Address1 *string `json:"address1,omitempty"`
 
-	// Name Name of the Secure Agent group.
-	Name string `json:"name"`
-}
+	// Address2 Second line of the organization's address.
+	Address2 *string `json:"address2,omitempty"`
 
-// RuntimeEnvironmentDataMinimalType defines model for RuntimeEnvironmentDataMinimal.Type.
-type RuntimeEnvironmentDataMinimalType string
+	// City City of the organization's address.
+	City *string `json:"city,omitempty"`
 
-// UpdateRuntimeEnvironmentRequestBody defines model for updateRuntimeEnvironmentRequestBody.
-type UpdateRuntimeEnvironmentRequestBody struct {
-	Type *UpdateRuntimeEnvironmentRequestBodyType `json:"@type,omitempty"`
+	// ContactEmail Email address of the organization's contact.
+	ContactEmail *string `json:"contactEmail,omitempty"`
 
-	// Agents Agents assigned to the Secure Agent group.
-	Agents *[]RuntimeEnvironmentAgent `json:"agents,omitempty"`
+	// ContactFirstName First name of the organization's contact.
+	ContactFirstName *string `json:"contactFirstName,omitempty"`
 
-	// IsShared Whether the Secure Agent group can be shared with sub-organizations.
-	IsShared *bool `json:"isShared,omitempty"`
+	// ContactLastName Last name of the organization's contact.
+	ContactLastName *string `json:"contactLastName,omitempty"`
 
-	// Name Name of the Secure Agent group.
-	Name string `json:"name"`
-}
+	// ContactPhone Phone number of the organization's contact.
+	ContactPhone *string `json:"contactPhone,omitempty"`
 
-// UpdateRuntimeEnvironmentRequestBodyType defines model for UpdateRuntimeEnvironmentRequestBody.Type.
-type UpdateRuntimeEnvironmentRequestBodyType string
+	// Country Country of the organization's address.
+	Country *string `json:"country,omitempty"`
 
-// N400 defines model for 400.
-type N400 = ApiErrorResponse
+	// CreateTime Date and time the organization was created.
+	CreateTime *string `json:"createTime,omitempty"`
 
-// N401 defines model for 401.
-type N401 = ApiErrorResponse
+	// CreatedBy User who created the organization.
+	CreatedBy *string `json:"createdBy,omitempty"`
 
-// N403 defines model for 403.
-type N403 = ApiErrorResponse
+	// Description Description of the organization.
+	Description *string `json:"description,omitempty"`
 
-// N404 defines model for 404.
-type N404 = ApiErrorResponse
+	// EnableTwoFactorAuth Whether users must use two-factor authentication to log in.
+	EnableTwoFactorAuth *bool `json:"enableTwoFactorAuth,omitempty"`
 
-// N500 defines model for 500.
-type N500 = ApiErrorResponse
+	// Id Organization ID.
+	Id *string `json:"id,omitempty"`
 
-// N502 defines model for 502.
-type N502 = ApiErrorResponse
+	// MinPasswordCharMix Number of character classes (lowercase, uppercase, numeric, special) a user password must mix.
+	MinPasswordCharMix *int64 `json:"minPasswordCharMix,omitempty"`
 
-// N503 defines model for 503.
-type N503 = ApiErrorResponse
+	// MinPasswordLength Minimum number of characters a user password must contain.
+	MinPasswordLength *int64 `json:"minPasswordLength,omitempty"`
 
-// <editor-fold desc="param-types" defaultstate="collapsed"> ///////////////////
+	// Name Name of the organization.
+	Name string `json:"name"`
 
-// </editor-fold> //////////////////////////////////////////////////////////////
+	// OrgType Type of the organization, such as 'PARENT' or 'SUB'.
+	OrgType *string `json:"orgType,omitempty"`
 
-// <editor-fold desc="request-bodies" defaultstate="collapsed"> ////////////////
+	// ParentOrgId ID of the parent organization, if this is a sub-organization.
+	ParentOrgId *string `json:"parentOrgId,omitempty"`
 
-// CreateRuntimeEnvironmentJSONRequestBody defines body for CreateRuntimeEnvironment for application/json ContentType.
-type CreateRuntimeEnvironmentJSONRequestBody = RuntimeEnvironmentDataMinimal
+	// PasswordExpirationInDays Number of days until a user password expires.
+	PasswordExpirationInDays *int64 `json:"passwordExpirationInDays,omitempty"`
 
-// UpdateRuntimeEnvironmentJSONRequestBody defines body for UpdateRuntimeEnvironment for application/json ContentType.
-type UpdateRuntimeEnvironmentJSONRequestBody = UpdateRuntimeEnvironmentRequestBody
+	// PasswordReuseInDays Number of days before a user can reuse a previous password.
+	PasswordReuseInDays *int64 `json:"passwordReuseInDays,omitempty"`
 
-// LoginJSONRequestBody defines body for Login for application/json ContentType.
-type LoginJSONRequestBody = LoginRequestBody
+	// SessionTimeout Number of minutes a session can be idle before it times out.
+	SessionTimeout *int64 `json:"sessionTimeout,omitempty"`
 
-// </editor-fold> //////////////////////////////////////////////////////////////
+	// State State of the organization's address.
+	State *string `json:"state,omitempty"`
 
-// AsApiErrorResponseBody returns the union data inside the ApiErrorResponse as a ApiErrorResponseBody
-func (t ApiErrorResponse) AsApiErrorResponseBody() (ApiErrorResponseBody, error) {
-	var body ApiErrorResponseBody
-	err := json.Unmarshal(t.union, &body)
-	return body, err
-}
+	// SubOrgs The sub-organizations of the organization.
+	SubOrgs *[]OrgRef `json:"subOrgs,omitempty"`
 
-// FromApiErrorResponseBody overwrites any union data inside the ApiErrorResponse as the provided ApiErrorResponseBody
-func (t *ApiErrorResponse) FromApiErrorResponseBody(v ApiErrorResponseBody) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
-}
+	// Timezone Default time zone of the organization.
+	Timezone *string `json:"timezone,omitempty"`
 
-// MergeApiErrorResponseBody performs a merge with any union data inside the ApiErrorResponse, using the provided ApiErrorResponseBody
-func (t *ApiErrorResponse) MergeApiErrorResponseBody(v ApiErrorResponseBody) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+	// UpdateTime Date and time the organization was last updated.
+	UpdateTime *string `json:"updateTime,omitempty"`
 
-	merged, err := runtime.JSONMerge(t.union, b)
-	t.union = merged
-	return err
-}
+	// UpdatedBy User who last updated the organization.
+	UpdatedBy *string `json:"updatedBy,omitempty"`
 
-// AsExternalRef1ApiErrorResponseBody returns the union data inside the ApiErrorResponse as a externalRef1.ApiErrorResponseBody
-func (t ApiErrorResponse) AsExternalRef1ApiErrorResponseBody() (externalRef1.ApiErrorResponseBody, error) {
-	var body externalRef1.ApiErrorResponseBody
-	err := json.Unmarshal(t.union, &body)
-	return body, err
+	// Zipcode Postal code of the organization's address.
+	Zipcode *string `json:"zipcode,omitempty"`
 }
 
-// FromExternalRef1ApiErrorResponseBody overwrites any union data inside the ApiErrorResponse as the provided externalRef1.ApiErrorResponseBody
-func (t *ApiErrorResponse) FromExternalRef1ApiErrorResponseBody(v externalRef1.ApiErrorResponseBody) error {
-	b, err := json.Marshal(v)
-	t.union = b
-	return err
-}
+// OrgType defines model for Org.Type.
+type OrgType string
 
-// MergeExternalRef1ApiErrorResponseBody performs a merge with any union data inside the ApiErrorResponse, using the provided externalRef1.ApiErrorResponseBody
-func (t *ApiErrorResponse) MergeExternalRef1ApiErrorResponseBody(v externalRef1.ApiErrorResponseBody) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
-	}
+// OrgData defines model for orgData.
+type OrgData struct {
+	Type *OrgDataType `json:"@type,omitempty"`
 
-	merged, err := runtime.JSONMerge(t.union, b)
-	t.union = merged
-	return err
-}
+	// Address1 First line of the organization's address.
+	Address1 *string `json:"address1,omitempty"`
 
-func (t ApiErrorResponse) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
-}
+	// Address2 Second line of the organization's address.
+	Address2 *string `json:"address2,omitempty"`
 
-func (t *ApiErrorResponse) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
-}
+	// City City of the organization's address.
+	City *string `json:"city,omitempty"`
 
-// <editor-fold desc="client" defaultstate="collapsed"> ////////////////////////
+	// ContactEmail Email address of the organization's contact.
+	ContactEmail *string `json:"contactEmail,omitempty"`
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	common.ClientConfig
-}
+	// ContactFirstName First name of the organization's contact.
+	ContactFirstName *string `json:"contactFirstName,omitempty"`
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...common.ClientOption) (*Client, error) {
-	config, err := common.NewClientConfig(server, opts...)
-	return &Client{*config}, err
-}
+	// ContactLastName Last name of the organization's contact.
+	ContactLastName *string `json:"contactLastName,omitempty"`
 
-var _ common.Client = &Client{}
+	// ContactPhone Phone number of the organization's contact.
+	ContactPhone *string `json:"contactPhone,omitempty"`
 
-func (c *Client) Config() *common.ClientConfig {
-	return &c.ClientConfig
-}
+	// Country Country of the organization's address.
+	Country *string `json:"country,omitempty"`
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// GetAgentInstallerInfo request
-	GetAgentInstallerInfo(ctx context.Context, platform string, editors ...common.ClientConfigEditor) (*http.Response, error)
+	// Description Description of the organization.
+	Description *string `json:"description,omitempty"`
 
-	// ListRuntimeEnvironments request
-	ListRuntimeEnvironments(ctx context.Context, editors ...common.ClientConfigEditor) (*http.Response, error)
+	// EnableTwoFactorAuth Whether users must use two-factor authentication to log in.
+	EnableTwoFactorAuth *bool `json:"enableTwoFactorAuth,omitempty"`
 
-	// CreateRuntimeEnvironmentWithBody request with any body
-	CreateRuntimeEnvironmentWithBody(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+	// MinPasswordCharMix Number of character classes (lowercase, uppercase, numeric, special) a user password must mix.
+	MinPasswordCharMix *int64 `json:"minPasswordCharMix,omitempty"`
 
-	CreateRuntimeEnvironment(ctx context.Context, body CreateRuntimeEnvironmentJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+	// MinPasswordLength Minimum number of characters a user password must contain.
+	MinPasswordLength *int64 `json:"minPasswordLength,omitempty"`
 
-	// DeleteRuntimeEnvironment request
-	DeleteRuntimeEnvironment(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error)
+	// Name Name of the organization.
+	Name string `json:"name"`
 
-	// GetRuntimeEnvironment request
-	GetRuntimeEnvironment(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error)
+	// PasswordExpirationInDays Number of days until a user password expires.
+	PasswordExpirationInDays *int64 `json:"passwordExpirationInDays,omitempty"`
 
-	// UpdateRuntimeEnvironmentWithBody request with any body
-	UpdateRuntimeEnvironmentWithBody(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+	// PasswordReuseInDays Number of days before a user can reuse a previous password.
+	PasswordReuseInDays *int64 `json:"passwordReuseInDays,omitempty"`
 
-	UpdateRuntimeEnvironment(ctx context.Context, id string, body UpdateRuntimeEnvironmentJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+	// SessionTimeout Number of minutes a session can be idle before it times out.
+	SessionTimeout *int64 `json:"sessionTimeout,omitempty"`
 
-	// LoginWithBody request with any body
-	LoginWithBody(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+	// State State of the organization's address.
+	State *string `json:"state,omitempty"`
 
-	Login(ctx context.Context, body LoginJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
-}
+	// Timezone Default time zone of the organization.
+	Timezone *string `json:"timezone,omitempty"`
 
-func (c *Client) GetAgentInstallerInfo(ctx context.Context, platform string, editors ...common.ClientConfigEditor) (*http.Response, error) {
-	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
-		return NewGetAgentInstallerInfoRequest(c.Server, platform)
-	})
+	// Zipcode Postal code of the organization's address.
+	Zipcode *string `json:"zipcode,omitempty"`
 }
 
-func (c *Client) ListRuntimeEnvironments(ctx context.Context, editors ...common.ClientConfigEditor) (*http.Response, error) {
-	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
-		return NewListRuntimeEnvironmentsRequest(c.Server)
-	})
-}
+// OrgDataType defines model for OrgData.Type.
+type OrgDataType string
 
-func (c *Client) CreateRuntimeEnvironmentWithBody(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
-	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
-		return NewCreateRuntimeEnvironmentRequestWithBody(c.Server, contentType, body)
-	})
-}
+// OrgDataBulk defines model for orgDataBulk.
+type OrgDataBulk struct {
+	// CreateTime Date and time the organization was created.
+	CreateTime *string `json:"createTime,omitempty"`
 
-func (c *Client) CreateRuntimeEnvironment(ctx context.Context, body CreateRuntimeEnvironmentJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
-	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
-		return NewCreateRuntimeEnvironmentRequest(c.Server, body)
-	})
-}
+	// CreatedBy User who created the organization.
+	CreatedBy *string `json:"createdBy,omitempty"`
 
-func (c *Client) DeleteRuntimeEnvironment(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error) {
-	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
-		return NewDeleteRuntimeEnvironmentRequest(c.Server, id)
-	})
-}
+	// Id Organization ID.
+	Id *string `json:"id,omitempty"`
 
-func (c *Client) GetRuntimeEnvironment(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error) {
-	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
-		return NewGetRuntimeEnvironmentRequest(c.Server, id)
-	})
-}
+	// OrgType Type of the organization, such as 'PARENT' or 'SUB'.
+	OrgType *string `json:"orgType,omitempty"`
 
-func (c *Client) UpdateRuntimeEnvironmentWithBody(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
-	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
-		return NewUpdateRuntimeEnvironmentRequestWithBody(c.Server, id, contentType, body)
-	})
-}
+	// ParentOrgId ID of the parent organization, if this is a sub-organization.
+	ParentOrgId *string `json:"parentOrgId,omitempty"`
 
-func (c *Client) UpdateRuntimeEnvironment(ctx context.Context, id string, body UpdateRuntimeEnvironmentJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
-	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
-		return NewUpdateRuntimeEnvironmentRequest(c.Server, id, body)
-	})
-}
+	// SubOrgs The sub-organizations of the organization.
+	SubOrgs *[]OrgRef `json:"subOrgs,omitempty"`
 
-func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
-	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
-		return NewLoginRequestWithBody(c.Server, contentType, body)
-	})
-}
+	// UpdateTime Date and time the organization was last updated.
+	UpdateTime *string `json:"updateTime,omitempty"`
 
-func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
-	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
-		return NewLoginRequest(c.Server, body)
-	})
+	// UpdatedBy User who last updated the organization.
+	UpdatedBy *string `json:"updatedBy,omitempty"`
 }
 
-// NewGetAgentInstallerInfoRequest generates requests for GetAgentInstallerInfo
-func NewGetAgentInstallerInfoRequest(server string, platform string) (*http.Request, error) {
-	var err error
+// OrgRef defines model for orgRef.
+type OrgRef struct {
+	// Id Organization ID.
+	Id *string `json:"id,omitempty"`
 
-	var pathParam0 string
+	// Name Name of the organization.
+	Name *string `json:"name,omitempty"`
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "platform", runtime.ParamLocationPath, platform)
-	if err != nil {
-		return nil, err
-	}
+// RuntimeEnvironment defines model for runtimeEnvironment.
+type RuntimeEnvironment struct {
+	Type *RuntimeEnvironmentType `json:"@type,omitempty"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// Agents Agents assigned to the Secure Agent group.
+	Agents *[]RuntimeEnvironmentAgent `json:"agents,omitempty"`
 
-	operationPath := fmt.Sprintf("/api/v2/agent/installerInfo/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// CreateTime Date and time the Secure Agent group was created.
+	CreateTime *string `json:"createTime,omitempty"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// CreatedBy User who created the Secure Agent group.
+	CreatedBy *string `json:"createdBy,omitempty"`
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// Description Description of the Secure Agent group.
+	Description *string `json:"description,omitempty"`
 
-	return req, nil
-}
+	// FederatedId Global unique identifier.
+	FederatedId *string `json:"federatedId,omitempty"`
 
-// NewListRuntimeEnvironmentsRequest generates requests for ListRuntimeEnvironments
-func NewListRuntimeEnvironmentsRequest(server string) (*http.Request, error) {
-	var err error
+	// Id Secure Agent group ID.
+	Id *string `json:"id,omitempty"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// IsShared Whether the Secure Agent group can be shared with sub-organizations.
+	IsShared *bool `json:"isShared,omitempty"`
 
-	operationPath := fmt.Sprintf("/api/v2/runtimeEnvironment")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// Name Secure Agent group name.
+	Name string `json:"name"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// OrgId Organization ID.
+	OrgId *string `json:"orgId,omitempty"`
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// ServerlessConfig Attribute that defines serverless runtime environment properties.
+	ServerlessConfig *map[string]interface{} `json:"serverlessConfig,omitempty"`
 
-	return req, nil
-}
+	// UpdateTime Date and time that the Secure Agent group was last updated.
+	UpdateTime *string `json:"updateTime,omitempty"`
 
-// NewCreateRuntimeEnvironmentRequest calls the generic CreateRuntimeEnvironment builder with application/json body
-func NewCreateRuntimeEnvironmentRequest(server string, body CreateRuntimeEnvironmentJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateRuntimeEnvironmentRequestWithBody(server, "application/json", bodyReader)
+	// UpdatedBy User who last updated the Secure Agent group.
+	UpdatedBy *string `json:"updatedBy,omitempty"`
 }
 
-// NewCreateRuntimeEnvironmentRequestWithBody generates requests for CreateRuntimeEnvironment with any type of body
-func NewCreateRuntimeEnvironmentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// RuntimeEnvironmentType defines model for RuntimeEnvironment.Type.
+type RuntimeEnvironmentType string
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// RuntimeEnvironmentAgent An agent assigned to a Secure Agent group.
+type RuntimeEnvironmentAgent struct {
+	// Id Agent ID.
+	Id *string `json:"id,omitempty"`
 
-	operationPath := fmt.Sprintf("/api/v2/runtimeEnvironment")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// OrgId Organization ID.
+	OrgId *string `json:"orgId,omitempty"`
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// RuntimeEnvironmentDataBulk defines model for runtimeEnvironmentDataBulk.
+type RuntimeEnvironmentDataBulk struct {
+	// Agents Agents assigned to the Secure Agent group.
+	Agents *[]RuntimeEnvironmentAgent `json:"agents,omitempty"`
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// CreateTime Date and time the Secure Agent group was created.
+	CreateTime *string `json:"createTime,omitempty"`
 
-	req.Header.Add("Content-Type", contentType)
+	// CreatedBy User who created the Secure Agent group.
+	CreatedBy *string `json:"createdBy,omitempty"`
 
-	return req, nil
+	// FederatedId Global unique identifier.
+	FederatedId *string `json:"federatedId,omitempty"`
+
+	// Id Secure Agent group ID.
+	Id *string `json:"id,omitempty"`
+
+	// Name Secure Agent group name.
+	Name *string `json:"name,omitempty"`
+
+	// OrgId Organization ID.
+	OrgId *string `json:"orgId,omitempty"`
+
+	// ServerlessConfig Attribute that defines serverless runtime environment properties.
+	ServerlessConfig *map[string]interface{} `json:"serverlessConfig,omitempty"`
+
+	// UpdateTime Date and time that the Secure Agent group was last updated.
+	UpdateTime *string `json:"updateTime,omitempty"`
+
+	// UpdatedBy User who last updated the Secure Agent group.
+	UpdatedBy *string `json:"updatedBy,omitempty"`
 }
 
-// NewDeleteRuntimeEnvironmentRequest generates requests for DeleteRuntimeEnvironment
-func NewDeleteRuntimeEnvironmentRequest(server string, id string) (*http.Request, error) {
-	var err error
+// RuntimeEnvironmentDataMinimal defines model for runtimeEnvironmentDataMinimal.
+type RuntimeEnvironmentDataMinimal struct {
+	Type *RuntimeEnvironmentDataMinimalType `json:"@type,omitempty"`
 
-	var pathParam0 string
+	// Description Description of the Secure Agent group.
+	Description *string `json:"description,omitempty"`
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
+	// IsShared Whether the Secure Agent group can be shared with sub-organizations.
+	IsShared *bool `json:"isShared,omitempty"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// Name Name of the Secure Agent group.
+	Name string `json:"name"`
+}
 
-	operationPath := fmt.Sprintf("/api/v2/runtimeEnvironment/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// RuntimeEnvironmentDataMinimalType defines model for RuntimeEnvironmentDataMinimal.Type.
+type RuntimeEnvironmentDataMinimalType string
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// RuntimeEnvironmentService defines model for runtimeEnvironmentService.
+type RuntimeEnvironmentService struct {
+	// DisplayName Display name of the service.
+	DisplayName *string `json:"displayName,omitempty"`
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// Enabled Whether the service runs on the agents of the Secure Agent group.
+	Enabled bool `json:"enabled"`
 
-	return req, nil
+	// Name Name of the service, such as 'Data Integration Server'.
+	Name string `json:"name"`
 }
 
-// NewGetRuntimeEnvironmentRequest generates requests for GetRuntimeEnvironment
-func NewGetRuntimeEnvironmentRequest(server string, id string) (*http.Request, error) {
-	var err error
+// RuntimeEnvironmentServiceList defines model for runtimeEnvironmentServiceList.
+type RuntimeEnvironmentServiceList struct {
+	// Services Services available to the Secure Agent group.
+	Services []RuntimeEnvironmentService `json:"services"`
+}
 
-	var pathParam0 string
+// TaskType The type of task:
+// * DMASK: Masking task.
+// * DRS: Replication task.
+// * DSS: Synchronization task.
+// * MTT: Mapping task.
+// * PCS: PowerCenter task.
+// * WORKFLOW: Linear taskflow.
+// * TASKFLOW: Taskflow.
+type TaskType string
+
+// UpdateAgentRequestBody defines model for updateAgentRequestBody.
+type UpdateAgentRequestBody struct {
+	Type *UpdateAgentRequestBodyType `json:"@type,omitempty"`
+
+	// AgentConfigs Configuration properties to change.
+	AgentConfigs []AgentConfig `json:"agentConfigs"`
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
+// UpdateAgentRequestBodyType defines model for UpdateAgentRequestBody.Type.
+type UpdateAgentRequestBodyType string
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// UpdateRuntimeEnvironmentRequestBody defines model for updateRuntimeEnvironmentRequestBody.
+type UpdateRuntimeEnvironmentRequestBody struct {
+	Type *UpdateRuntimeEnvironmentRequestBodyType `json:"@type,omitempty"`
 
-	operationPath := fmt.Sprintf("/api/v2/runtimeEnvironment/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// Agents Agents assigned to the Secure Agent group.
+	Agents *[]RuntimeEnvironmentAgent `json:"agents,omitempty"`
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// Description Description of the Secure Agent group.
+	Description *string `json:"description,omitempty"`
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// IsShared Whether the Secure Agent group can be shared with sub-organizations.
+	IsShared *bool `json:"isShared,omitempty"`
 
-	return req, nil
+	// Name Name of the Secure Agent group.
+	Name string `json:"name"`
 }
 
-// NewUpdateRuntimeEnvironmentRequest calls the generic UpdateRuntimeEnvironment builder with application/json body
-func NewUpdateRuntimeEnvironmentRequest(server string, id string, body UpdateRuntimeEnvironmentJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateRuntimeEnvironmentRequestWithBody(server, id, "application/json", bodyReader)
-}
+// UpdateRuntimeEnvironmentRequestBodyType defines model for UpdateRuntimeEnvironmentRequestBody.Type.
+type UpdateRuntimeEnvironmentRequestBodyType string
 
-// NewUpdateRuntimeEnvironmentRequestWithBody generates requests for UpdateRuntimeEnvironment with any type of body
-func NewUpdateRuntimeEnvironmentRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// HeaderSession defines model for headerSession.
+type HeaderSession = string
 
-	var pathParam0 string
+// N400 defines model for 400.
+type N400 = ApiErrorResponse
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
+// N401 defines model for 401.
+type N401 = ApiErrorResponse
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// N403 defines model for 403.
+type N403 = ApiErrorResponse
 
-	operationPath := fmt.Sprintf("/api/v2/runtimeEnvironment/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// N404 defines model for 404.
+type N404 = ApiErrorResponse
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// N500 defines model for 500.
+type N500 = ApiErrorResponse
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+// N502 defines model for 502.
+type N502 = ApiErrorResponse
 
-	req.Header.Add("Content-Type", contentType)
+// N503 defines model for 503.
+type N503 = ApiErrorResponse
 
-	return req, nil
-}
+// <editor-fold desc="param-types" defaultstate="collapsed"> ///////////////////
 
-// NewLoginRequest calls the generic Login builder with application/json body
-func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewLoginRequestWithBody(server, "application/json", bodyReader)
-}
+// GetActivityLogParams defines parameters for GetActivityLog.
+type GetActivityLogParams struct {
+	// TaskId Only return entries for this task.
+	TaskId *string `form:"taskId,omitempty" json:"taskId,omitempty"`
 
-// NewLoginRequestWithBody generates requests for Login with any type of body
-func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	// RunId Only return entries for this run of the task. Requires taskId.
+	RunId *int64 `form:"runId,omitempty" json:"runId,omitempty"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// Offset The number of entries to skip over, for paging through the log.
+	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
 
-	operationPath := fmt.Sprintf("/ma/api/v2/user/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// RowLimit The maximum number of entries to return. The api caps this at 1000.
+	RowLimit *int32 `form:"rowLimit,omitempty" json:"rowLimit,omitempty"`
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// GetAuditLogParams defines parameters for GetAuditLog.
+type GetAuditLogParams struct {
+	// BatchId The zero-based index of the batch of entries to return.
+	BatchId *int32 `form:"batchId,omitempty" json:"batchId,omitempty"`
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// BatchSize The number of entries in each batch. The api caps this at 200.
+	BatchSize *int32 `form:"batchSize,omitempty" json:"batchSize,omitempty"`
+}
 
-	req.Header.Add("Content-Type", contentType)
+// UpdateMappingTaskParams defines parameters for UpdateMappingTask.
+type UpdateMappingTaskParams struct {
+	// UpdateMode Whether to replace the whole task, or only the fields included in the request.
+	UpdateMode *UpdateMappingTaskParamsUpdateMode `json:"Update-Mode,omitempty"`
+}
 
-	return req, nil
+// UpdateMappingTaskParamsUpdateMode defines parameters for UpdateMappingTask.
+type UpdateMappingTaskParamsUpdateMode string
+
+// LogoutParams defines parameters for Logout.
+type LogoutParams struct {
+	IcSessionId HeaderSession `json:"icSessionId"`
 }
 
 // </editor-fold> //////////////////////////////////////////////////////////////
-// <editor-fold desc="client-with-responses" defaultstate="collapsed"> /////////
 
-// ClientWithResponses builds on Client to offer response payloads
-type ClientWithResponses struct {
-	*Client
-}
+// <editor-fold desc="request-bodies" defaultstate="collapsed"> ////////////////
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...common.ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &ClientWithResponses{client}, nil
-}
+// UpdateAgentJSONRequestBody defines body for UpdateAgent for application/json ContentType.
+type UpdateAgentJSONRequestBody = UpdateAgentRequestBody
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAgentInstallerInfoWithResponse request
-	GetAgentInstallerInfoWithResponse(ctx context.Context, platform string, editors ...common.ClientConfigEditor) (*GetAgentInstallerInfoResponse, error)
+// StartJobJSONRequestBody defines body for StartJob for application/json ContentType.
+type StartJobJSONRequestBody = JobRequestBody
 
-	// ListRuntimeEnvironmentsWithResponse request
-	ListRuntimeEnvironmentsWithResponse(ctx context.Context, editors ...common.ClientConfigEditor) (*ListRuntimeEnvironmentsResponse, error)
+// CreateMappingTaskJSONRequestBody defines body for CreateMappingTask for application/json ContentType.
+type CreateMappingTaskJSONRequestBody = MappingTaskData
 
-	// CreateRuntimeEnvironmentWithBodyWithResponse request with any body
-	CreateRuntimeEnvironmentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*CreateRuntimeEnvironmentResponse, error)
+// UpdateMappingTaskJSONRequestBody defines body for UpdateMappingTask for application/json ContentType.
+type UpdateMappingTaskJSONRequestBody = MappingTaskData
 
-	CreateRuntimeEnvironmentWithResponse(ctx context.Context, body CreateRuntimeEnvironmentJSONRequestBody, editors ...common.ClientConfigEditor) (*CreateRuntimeEnvironmentResponse, error)
+// CreateSubOrgJSONRequestBody defines body for CreateSubOrg for application/json ContentType.
+type CreateSubOrgJSONRequestBody = CreateSubOrgRequestBody
 
-	// DeleteRuntimeEnvironmentWithResponse request
-	DeleteRuntimeEnvironmentWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*DeleteRuntimeEnvironmentResponse, error)
+// UpdateOrgJSONRequestBody defines body for UpdateOrg for application/json ContentType.
+type UpdateOrgJSONRequestBody = OrgData
 
-	// GetRuntimeEnvironmentWithResponse request
-	GetRuntimeEnvironmentWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*GetRuntimeEnvironmentResponse, error)
+// CreateRuntimeEnvironmentJSONRequestBody defines body for CreateRuntimeEnvironment for application/json ContentType.
+type CreateRuntimeEnvironmentJSONRequestBody = RuntimeEnvironmentDataMinimal
 
-	// UpdateRuntimeEnvironmentWithBodyWithResponse request with any body
-	UpdateRuntimeEnvironmentWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateRuntimeEnvironmentResponse, error)
+// UpdateRuntimeEnvironmentJSONRequestBody defines body for UpdateRuntimeEnvironment for application/json ContentType.
+type UpdateRuntimeEnvironmentJSONRequestBody = UpdateRuntimeEnvironmentRequestBody
 
-	UpdateRuntimeEnvironmentWithResponse(ctx context.Context, id string, body UpdateRuntimeEnvironmentJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateRuntimeEnvironmentResponse, error)
+// UpdateRuntimeEnvironmentConfigsJSONRequestBody defines body for UpdateRuntimeEnvironmentConfigs for application/json ContentType.
+type UpdateRuntimeEnvironmentConfigsJSONRequestBody = AgentConfigList
 
-	// LoginWithBodyWithResponse request with any body
-	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*LoginResponse, error)
+// UpdateRuntimeEnvironmentServicesJSONRequestBody defines body for UpdateRuntimeEnvironmentServices for application/json ContentType.
+type UpdateRuntimeEnvironmentServicesJSONRequestBody = RuntimeEnvironmentServiceList
 
-	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, editors ...common.ClientConfigEditor) (*LoginResponse, error)
+// LoginJSONRequestBody defines body for Login for application/json ContentType.
+type LoginJSONRequestBody = LoginRequestBody
+
+// </editor-fold> //////////////////////////////////////////////////////////////
+
+// AsApiErrorResponseBody returns the union data inside the ApiErrorResponse as a ApiErrorResponseBody
+func (t ApiErrorResponse) AsApiErrorResponseBody() (ApiErrorResponseBody, error) {
+	var body ApiErrorResponseBody
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-type GetAgentInstallerInfoResponse struct {
-	common.ClientResponse
-	JSON200 *GetAgentInstallerInfoResponseBody
-	JSON400 *N400
-	JSON401 *N401
-	JSON403 *N403
-	JSON404 *N404
-	JSON500 *N500
-	JSON502 *N502
-	JSON503 *N503
+// FromApiErrorResponseBody overwrites any union data inside the ApiErrorResponse as the provided ApiErrorResponseBody
+func (t *ApiErrorResponse) FromApiErrorResponseBody(v ApiErrorResponseBody) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAgentInstallerInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// MergeApiErrorResponseBody performs a merge with any union data inside the ApiErrorResponse, using the provided ApiErrorResponseBody
+func (t *ApiErrorResponse) MergeApiErrorResponseBody(v ApiErrorResponseBody) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
 	}
-	return http.StatusText(0)
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAgentInstallerInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// AsExternalRef1ApiErrorResponseBody returns the union data inside the ApiErrorResponse as a externalRef1.ApiErrorResponseBody
+func (t ApiErrorResponse) AsExternalRef1ApiErrorResponseBody() (externalRef1.ApiErrorResponseBody, error) {
+	var body externalRef1.ApiErrorResponseBody
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-// HttpResponse returns HTTPResponse
-func (r GetAgentInstallerInfoResponse) HttpResponse() *http.Response {
-	return r.HTTPResponse
+// FromExternalRef1ApiErrorResponseBody overwrites any union data inside the ApiErrorResponse as the provided externalRef1.ApiErrorResponseBody
+func (t *ApiErrorResponse) FromExternalRef1ApiErrorResponseBody(v externalRef1.ApiErrorResponseBody) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-// BodyData returns HTTPResponse.Body
-func (r GetAgentInstallerInfoResponse) BodyData() []byte {
-	return r.Body
+// MergeExternalRef1ApiErrorResponseBody performs a merge with any union data inside the ApiErrorResponse, using the provided externalRef1.ApiErrorResponseBody
+func (t *ApiErrorResponse) MergeExternalRef1ApiErrorResponseBody(v externalRef1.ApiErrorResponseBody) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-type ListRuntimeEnvironmentsResponse struct {
-	common.ClientResponse
-	JSON400 *N400
-	JSON401 *N401
-	JSON403 *N403
-	JSON404 *N404
-	JSON500 *N500
-	JSON502 *N502
-	JSON503 *N503
+func (t ApiErrorResponse) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
 }
 
-// Status returns HTTPResponse.Status
-func (r ListRuntimeEnvironmentsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+func (t *ApiErrorResponse) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListRuntimeEnvironmentsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// <editor-fold desc="client" defaultstate="collapsed"> ////////////////////////
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	common.ClientConfig
 }
 
-// HttpResponse returns HTTPResponse
-func (r ListRuntimeEnvironmentsResponse) HttpResponse() *http.Response {
-	return r.HTTPResponse
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...common.ClientOption) (*Client, error) {
+	config, err := common.NewClientConfig(server, opts...)
+	return &Client{*config}, err
 }
 
-// BodyData returns HTTPResponse.Body
-func (r ListRuntimeEnvironmentsResponse) BodyData() []byte {
-	return r.Body
+var _ common.Client = &Client{}
+
+func (c *Client) Config() *common.ClientConfig {
+	return &c.ClientConfig
 }
 
-type CreateRuntimeEnvironmentResponse struct {
-	common.ClientResponse
-	JSON200 *RuntimeEnvironment
-	JSON400 *N400
-	JSON401 *N401
-	JSON403 *N403
-	JSON404 *N404
-	JSON500 *N500
-	JSON502 *N502
-	JSON503 *N503
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetActivityLog request
+	GetActivityLog(ctx context.Context, params *GetActivityLogParams, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// GetAgentDetails request
+	GetAgentDetails(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// GetAgentInstallerInfo request
+	GetAgentInstallerInfo(ctx context.Context, platform string, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// GetAgent request
+	GetAgent(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// UpdateAgentWithBody request with any body
+	UpdateAgentWithBody(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	UpdateAgent(ctx context.Context, id string, body UpdateAgentJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// GetAuditLog request
+	GetAuditLog(ctx context.Context, params *GetAuditLogParams, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// StartJobWithBody request with any body
+	StartJobWithBody(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	StartJob(ctx context.Context, body StartJobJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// CreateMappingTaskWithBody request with any body
+	CreateMappingTaskWithBody(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	CreateMappingTask(ctx context.Context, body CreateMappingTaskJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// DeleteMappingTask request
+	DeleteMappingTask(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// GetMappingTask request
+	GetMappingTask(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// UpdateMappingTaskWithBody request with any body
+	UpdateMappingTaskWithBody(ctx context.Context, id string, params *UpdateMappingTaskParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	UpdateMappingTask(ctx context.Context, id string, params *UpdateMappingTaskParams, body UpdateMappingTaskJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// GetCurrentOrg request
+	GetCurrentOrg(ctx context.Context, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// CreateSubOrgWithBody request with any body
+	CreateSubOrgWithBody(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	CreateSubOrg(ctx context.Context, body CreateSubOrgJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// DeleteSubOrg request
+	DeleteSubOrg(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// GetOrg request
+	GetOrg(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// UpdateOrgWithBody request with any body
+	UpdateOrgWithBody(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	UpdateOrg(ctx context.Context, id string, body UpdateOrgJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// ListRuntimeEnvironments request
+	ListRuntimeEnvironments(ctx context.Context, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// CreateRuntimeEnvironmentWithBody request with any body
+	CreateRuntimeEnvironmentWithBody(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	CreateRuntimeEnvironment(ctx context.Context, body CreateRuntimeEnvironmentJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// DeleteRuntimeEnvironment request
+	DeleteRuntimeEnvironment(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// GetRuntimeEnvironment request
+	GetRuntimeEnvironment(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// UpdateRuntimeEnvironmentWithBody request with any body
+	UpdateRuntimeEnvironmentWithBody(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	UpdateRuntimeEnvironment(ctx context.Context, id string, body UpdateRuntimeEnvironmentJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// GetRuntimeEnvironmentConfigs request
+	GetRuntimeEnvironmentConfigs(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// UpdateRuntimeEnvironmentConfigsWithBody request with any body
+	UpdateRuntimeEnvironmentConfigsWithBody(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	UpdateRuntimeEnvironmentConfigs(ctx context.Context, id string, body UpdateRuntimeEnvironmentConfigsJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// GetRuntimeEnvironmentServices request
+	GetRuntimeEnvironmentServices(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// UpdateRuntimeEnvironmentServicesWithBody request with any body
+	UpdateRuntimeEnvironmentServicesWithBody(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	UpdateRuntimeEnvironmentServices(ctx context.Context, id string, body UpdateRuntimeEnvironmentServicesJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// LoginWithBody request with any body
+	LoginWithBody(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	Login(ctx context.Context, body LoginJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// Logout request
+	Logout(ctx context.Context, params *LogoutParams, editors ...common.ClientConfigEditor) (*http.Response, error)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateRuntimeEnvironmentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+func (c *Client) GetActivityLog(ctx context.Context, params *GetActivityLogParams, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewGetActivityLogRequest(c.Server, params)
+	})
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateRuntimeEnvironmentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+func (c *Client) GetAgentDetails(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewGetAgentDetailsRequest(c.Server, id)
+	})
 }
 
-// HttpResponse returns HTTPResponse
-func (r CreateRuntimeEnvironmentResponse) HttpResponse() *http.Response {
-	return r.HTTPResponse
+func (c *Client) GetAgentInstallerInfo(ctx context.Context, platform string, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewGetAgentInstallerInfoRequest(c.Server, platform)
+	})
 }
 
-// BodyData returns HTTPResponse.Body
-func (r CreateRuntimeEnvironmentResponse) BodyData() []byte {
-	return r.Body
+func (c *Client) GetAgent(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewGetAgentRequest(c.Server, id)
+	})
 }
 
-type DeleteRuntimeEnvironmentResponse struct {
-	common.ClientResponse
-	JSON400 *N400
-	JSON401 *N401
-	JSON403 *N403
-	JSON404 *N404
-	JSON500 *N500
-	JSON502 *N502
-	JSON503 *N503
+func (c *Client) UpdateAgentWithBody(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateAgentRequestWithBody(c.Server, id, contentType, body)
+	})
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteRuntimeEnvironmentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+func (c *Client) UpdateAgent(ctx context.Context, id string, body UpdateAgentJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateAgentRequest(c.Server, id, body)
+	})
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteRuntimeEnvironmentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+func (c *Client) GetAuditLog(ctx context.Context, params *GetAuditLogParams, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewGetAuditLogRequest(c.Server, params)
+	})
 }
 
-// HttpResponse returns HTTPResponse
-func (r DeleteRuntimeEnvironmentResponse) HttpResponse() *http.Response {
-	return r.HTTPResponse
+func (c *Client) StartJobWithBody(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewStartJobRequestWithBody(c.Server, contentType, body)
+	})
 }
 
-// BodyData returns HTTPResponse.Body
-func (r DeleteRuntimeEnvironmentResponse) BodyData() []byte {
-	return r.Body
+func (c *Client) StartJob(ctx context.Context, body StartJobJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewStartJobRequest(c.Server, body)
+	})
 }
 
-type GetRuntimeEnvironmentResponse struct {
-	common.ClientResponse
-	JSON200 *RuntimeEnvironment
-	JSON400 *N400
-	JSON401 *N401
-	JSON403 *N403
-	JSON404 *N404
-	JSON500 *N500
-	JSON502 *N502
-	JSON503 *N503
+func (c *Client) CreateMappingTaskWithBody(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewCreateMappingTaskRequestWithBody(c.Server, contentType, body)
+	})
 }
 
-// Status returns HTTPResponse.Status
-func (r GetRuntimeEnvironmentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+func (c *Client) CreateMappingTask(ctx context.Context, body CreateMappingTaskJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewCreateMappingTaskRequest(c.Server, body)
+	})
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetRuntimeEnvironmentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+func (c *Client) DeleteMappingTask(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewDeleteMappingTaskRequest(c.Server, id)
+	})
 }
 
-// HttpResponse returns HTTPResponse
-func (r GetRuntimeEnvironmentResponse) HttpResponse() *http.Response {
-	return r.HTTPResponse
+func (c *Client) GetMappingTask(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewGetMappingTaskRequest(c.Server, id)
+	})
 }
 
-// BodyData returns HTTPResponse.Body
-func (r GetRuntimeEnvironmentResponse) BodyData() []byte {
-	return r.Body
+func (c *Client) UpdateMappingTaskWithBody(ctx context.Context, id string, params *UpdateMappingTaskParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateMappingTaskRequestWithBody(c.Server, id, params, contentType, body)
+	})
 }
 
-type UpdateRuntimeEnvironmentResponse struct {
-	common.ClientResponse
-	JSON200 *RuntimeEnvironment
-	JSON400 *N400
-	JSON401 *N401
-	JSON403 *N403
-	JSON404 *N404
-	JSON500 *N500
-	JSON502 *N502
-	JSON503 *N503
+func (c *Client) UpdateMappingTask(ctx context.Context, id string, params *UpdateMappingTaskParams, body UpdateMappingTaskJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateMappingTaskRequest(c.Server, id, params, body)
+	})
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateRuntimeEnvironmentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+func (c *Client) GetCurrentOrg(ctx context.Context, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewGetCurrentOrgRequest(c.Server)
+	})
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateRuntimeEnvironmentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+func (c *Client) CreateSubOrgWithBody(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewCreateSubOrgRequestWithBody(c.Server, contentType, body)
+	})
 }
 
-// HttpResponse returns HTTPResponse
-func (r UpdateRuntimeEnvironmentResponse) HttpResponse() *http.Response {
-	return r.HTTPResponse
+func (c *Client) CreateSubOrg(ctx context.Context, body CreateSubOrgJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewCreateSubOrgRequest(c.Server, body)
+	})
 }
 
-// BodyData returns HTTPResponse.Body
-func (r UpdateRuntimeEnvironmentResponse) BodyData() []byte {
-	return r.Body
+func (c *Client) DeleteSubOrg(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewDeleteSubOrgRequest(c.Server, id)
+	})
 }
 
-type LoginResponse struct {
-	common.ClientResponse
-	JSON200 *LoginResponseBody
-	JSON400 *N400
-	JSON401 *N401
-	JSON403 *N403
-	JSON404 *N404
-	JSON500 *N500
-	JSON502 *N502
-	JSON503 *N503
+func (c *Client) GetOrg(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewGetOrgRequest(c.Server, id)
+	})
 }
 
-// Status returns HTTPResponse.Status
-func (r LoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+func (c *Client) UpdateOrgWithBody(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateOrgRequestWithBody(c.Server, id, contentType, body)
+	})
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+func (c *Client) UpdateOrg(ctx context.Context, id string, body UpdateOrgJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateOrgRequest(c.Server, id, body)
+	})
 }
 
-// HttpResponse returns HTTPResponse
-func (r LoginResponse) HttpResponse() *http.Response {
-	return r.HTTPResponse
+func (c *Client) ListRuntimeEnvironments(ctx context.Context, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewListRuntimeEnvironmentsRequest(c.Server)
+	})
 }
 
-// BodyData returns HTTPResponse.Body
-func (r LoginResponse) BodyData() []byte {
-	return r.Body
+func (c *Client) CreateRuntimeEnvironmentWithBody(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewCreateRuntimeEnvironmentRequestWithBody(c.Server, contentType, body)
+	})
 }
 
-// GetAgentInstallerInfoWithResponse request returning *GetAgentInstallerInfoResponse
-func (c *ClientWithResponses) GetAgentInstallerInfoWithResponse(ctx context.Context, platform string, editors ...common.ClientConfigEditor) (*GetAgentInstallerInfoResponse, error) {
-	rsp, err := c.GetAgentInstallerInfo(ctx, platform, editors...)
+func (c *Client) CreateRuntimeEnvironment(ctx context.Context, body CreateRuntimeEnvironmentJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewCreateRuntimeEnvironmentRequest(c.Server, body)
+	})
+}
+
+func (c *Client) DeleteRuntimeEnvironment(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewDeleteRuntimeEnvironmentRequest(c.Server, id)
+	})
+}
+
+func (c *Client) GetRuntimeEnvironment(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewGetRuntimeEnvironmentRequest(c.Server, id)
+	})
+}
+
+func (c *Client) UpdateRuntimeEnvironmentWithBody(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateRuntimeEnvironmentRequestWithBody(c.Server, id, contentType, body)
+	})
+}
+
+func (c *Client) UpdateRuntimeEnvironment(ctx context.Context, id string, body UpdateRuntimeEnvironmentJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateRuntimeEnvironmentRequest(c.Server, id, body)
+	})
+}
+
+func (c *Client) GetRuntimeEnvironmentConfigs(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewGetRuntimeEnvironmentConfigsRequest(c.Server, id)
+	})
+}
+
+func (c *Client) UpdateRuntimeEnvironmentConfigsWithBody(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateRuntimeEnvironmentConfigsRequestWithBody(c.Server, id, contentType, body)
+	})
+}
+
+func (c *Client) UpdateRuntimeEnvironmentConfigs(ctx context.Context, id string, body UpdateRuntimeEnvironmentConfigsJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateRuntimeEnvironmentConfigsRequest(c.Server, id, body)
+	})
+}
+
+func (c *Client) GetRuntimeEnvironmentServices(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewGetRuntimeEnvironmentServicesRequest(c.Server, id)
+	})
+}
+
+func (c *Client) UpdateRuntimeEnvironmentServicesWithBody(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateRuntimeEnvironmentServicesRequestWithBody(c.Server, id, contentType, body)
+	})
+}
+
+func (c *Client) UpdateRuntimeEnvironmentServices(ctx context.Context, id string, body UpdateRuntimeEnvironmentServicesJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateRuntimeEnvironmentServicesRequest(c.Server, id, body)
+	})
+}
+
+func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewLoginRequestWithBody(c.Server, contentType, body)
+	})
+}
+
+func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewLoginRequest(c.Server, body)
+	})
+}
+
+func (c *Client) Logout(ctx context.Context, params *LogoutParams, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewLogoutRequest(c.Server, params)
+	})
+}
+
+// NewGetActivityLogRequest generates requests for GetActivityLog
+func NewGetActivityLogRequest(server string, params *GetActivityLogParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	apiRes, err := ParseGetAgentInstallerInfoResponse(rsp)
+
+	operationPath := fmt.Sprintf("/api/v2/activity/activityLog")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TaskId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "taskId", runtime.ParamLocationQuery, *params.TaskId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RunId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "runId", runtime.ParamLocationQuery, *params.RunId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RowLimit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rowLimit", runtime.ParamLocationQuery, *params.RowLimit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
 		return nil, err
 	}
-	return apiRes, nil
+
+	return req, nil
 }
 
-// ListRuntimeEnvironmentsWithResponse request returning *ListRuntimeEnvironmentsResponse
-func (c *ClientWithResponses) ListRuntimeEnvironmentsWithResponse(ctx context.Context, editors ...common.ClientConfigEditor) (*ListRuntimeEnvironmentsResponse, error) {
-	rsp, err := c.ListRuntimeEnvironments(ctx, editors...)
+// NewGetAgentDetailsRequest generates requests for GetAgentDetails
+func NewGetAgentDetailsRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	apiRes, err := ParseListRuntimeEnvironmentsResponse(rsp)
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
-		return nil, err
+
+	operationPath := fmt.Sprintf("/api/v2/agent/details/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return apiRes, nil
-}
 
-// CreateRuntimeEnvironmentWithBodyWithResponse request with arbitrary body returning *CreateRuntimeEnvironmentResponse
-func (c *ClientWithResponses) CreateRuntimeEnvironmentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*CreateRuntimeEnvironmentResponse, error) {
-	rsp, err := c.CreateRuntimeEnvironmentWithBody(ctx, contentType, body, editors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	apiRes, err := ParseCreateRuntimeEnvironmentResponse(rsp)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+
+	return req, nil
+}
+
+// NewGetAgentInstallerInfoRequest generates requests for GetAgentInstallerInfo
+func NewGetAgentInstallerInfoRequest(server string, platform string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "platform", runtime.ParamLocationPath, platform)
+	if err != nil {
 		return nil, err
 	}
-	return apiRes, nil
-}
 
-func (c *ClientWithResponses) CreateRuntimeEnvironmentWithResponse(ctx context.Context, body CreateRuntimeEnvironmentJSONRequestBody, editors ...common.ClientConfigEditor) (*CreateRuntimeEnvironmentResponse, error) {
-	rsp, err := c.CreateRuntimeEnvironment(ctx, body, editors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	apiRes, err := ParseCreateRuntimeEnvironmentResponse(rsp)
+
+	operationPath := fmt.Sprintf("/api/v2/agent/installerInfo/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
 		return nil, err
 	}
-	return apiRes, nil
+
+	return req, nil
 }
 
-// DeleteRuntimeEnvironmentWithResponse request returning *DeleteRuntimeEnvironmentResponse
-func (c *ClientWithResponses) DeleteRuntimeEnvironmentWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*DeleteRuntimeEnvironmentResponse, error) {
-	rsp, err := c.DeleteRuntimeEnvironment(ctx, id, editors...)
+// NewGetAgentRequest generates requests for GetAgent
+func NewGetAgentRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	apiRes, err := ParseDeleteRuntimeEnvironmentResponse(rsp)
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
-		return nil, err
+
+	operationPath := fmt.Sprintf("/api/v2/agent/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return apiRes, nil
-}
 
-// GetRuntimeEnvironmentWithResponse request returning *GetRuntimeEnvironmentResponse
-func (c *ClientWithResponses) GetRuntimeEnvironmentWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*GetRuntimeEnvironmentResponse, error) {
-	rsp, err := c.GetRuntimeEnvironment(ctx, id, editors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	apiRes, err := ParseGetRuntimeEnvironmentResponse(rsp)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+
+	return req, nil
+}
+
+// NewUpdateAgentRequest calls the generic UpdateAgent builder with application/json body
+func NewUpdateAgentRequest(server string, id string, body UpdateAgentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
 		return nil, err
 	}
-	return apiRes, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateAgentRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// UpdateRuntimeEnvironmentWithBodyWithResponse request with arbitrary body returning *UpdateRuntimeEnvironmentResponse
-func (c *ClientWithResponses) UpdateRuntimeEnvironmentWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateRuntimeEnvironmentResponse, error) {
-	rsp, err := c.UpdateRuntimeEnvironmentWithBody(ctx, id, contentType, body, editors...)
+// NewUpdateAgentRequestWithBody generates requests for UpdateAgent with any type of body
+func NewUpdateAgentRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	apiRes, err := ParseUpdateRuntimeEnvironmentResponse(rsp)
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
-		return nil, err
+
+	operationPath := fmt.Sprintf("/api/v2/agent/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return apiRes, nil
-}
 
-func (c *ClientWithResponses) UpdateRuntimeEnvironmentWithResponse(ctx context.Context, id string, body UpdateRuntimeEnvironmentJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateRuntimeEnvironmentResponse, error) {
-	rsp, err := c.UpdateRuntimeEnvironment(ctx, id, body, editors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	apiRes, err := ParseUpdateRuntimeEnvironmentResponse(rsp)
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAuditLogRequest generates requests for GetAuditLog
+func NewGetAuditLogRequest(server string, params *GetAuditLogParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/auditlog")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.BatchId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "batchId", runtime.ParamLocationQuery, *params.BatchId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BatchSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "batchSize", runtime.ParamLocationQuery, *params.BatchSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStartJobRequest calls the generic StartJob builder with application/json body
+func NewStartJobRequest(server string, body StartJobJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewStartJobRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewStartJobRequestWithBody generates requests for StartJob with any type of body
+func NewStartJobRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/job")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateMappingTaskRequest calls the generic CreateMappingTask builder with application/json body
+func NewCreateMappingTaskRequest(server string, body CreateMappingTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateMappingTaskRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateMappingTaskRequestWithBody generates requests for CreateMappingTask with any type of body
+func NewCreateMappingTaskRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/mttask")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteMappingTaskRequest generates requests for DeleteMappingTask
+func NewDeleteMappingTaskRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/mttask/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMappingTaskRequest generates requests for GetMappingTask
+func NewGetMappingTaskRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/mttask/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateMappingTaskRequest calls the generic UpdateMappingTask builder with application/json body
+func NewUpdateMappingTaskRequest(server string, id string, params *UpdateMappingTaskParams, body UpdateMappingTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMappingTaskRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewUpdateMappingTaskRequestWithBody generates requests for UpdateMappingTask with any type of body
+func NewUpdateMappingTaskRequestWithBody(server string, id string, params *UpdateMappingTaskParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/mttask/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.UpdateMode != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Update-Mode", runtime.ParamLocationHeader, *params.UpdateMode)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Update-Mode", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetCurrentOrgRequest generates requests for GetCurrentOrg
+func NewGetCurrentOrgRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/org")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSubOrgRequest calls the generic CreateSubOrg builder with application/json body
+func NewCreateSubOrgRequest(server string, body CreateSubOrgJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSubOrgRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSubOrgRequestWithBody generates requests for CreateSubOrg with any type of body
+func NewCreateSubOrgRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/org")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSubOrgRequest generates requests for DeleteSubOrg
+func NewDeleteSubOrgRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/org/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrgRequest generates requests for GetOrg
+func NewGetOrgRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/org/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateOrgRequest calls the generic UpdateOrg builder with application/json body
+func NewUpdateOrgRequest(server string, id string, body UpdateOrgJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOrgRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateOrgRequestWithBody generates requests for UpdateOrg with any type of body
+func NewUpdateOrgRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/org/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListRuntimeEnvironmentsRequest generates requests for ListRuntimeEnvironments
+func NewListRuntimeEnvironmentsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/runtimeEnvironment")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateRuntimeEnvironmentRequest calls the generic CreateRuntimeEnvironment builder with application/json body
+func NewCreateRuntimeEnvironmentRequest(server string, body CreateRuntimeEnvironmentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRuntimeEnvironmentRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateRuntimeEnvironmentRequestWithBody generates requests for CreateRuntimeEnvironment with any type of body
+func NewCreateRuntimeEnvironmentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/runtimeEnvironment")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteRuntimeEnvironmentRequest generates requests for DeleteRuntimeEnvironment
+func NewDeleteRuntimeEnvironmentRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/runtimeEnvironment/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRuntimeEnvironmentRequest generates requests for GetRuntimeEnvironment
+func NewGetRuntimeEnvironmentRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/runtimeEnvironment/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateRuntimeEnvironmentRequest calls the generic UpdateRuntimeEnvironment builder with application/json body
+func NewUpdateRuntimeEnvironmentRequest(server string, id string, body UpdateRuntimeEnvironmentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRuntimeEnvironmentRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateRuntimeEnvironmentRequestWithBody generates requests for UpdateRuntimeEnvironment with any type of body
+func NewUpdateRuntimeEnvironmentRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/runtimeEnvironment/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRuntimeEnvironmentConfigsRequest generates requests for GetRuntimeEnvironmentConfigs
+func NewGetRuntimeEnvironmentConfigsRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/runtimeEnvironment/%s/configs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateRuntimeEnvironmentConfigsRequest calls the generic UpdateRuntimeEnvironmentConfigs builder with application/json body
+func NewUpdateRuntimeEnvironmentConfigsRequest(server string, id string, body UpdateRuntimeEnvironmentConfigsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRuntimeEnvironmentConfigsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateRuntimeEnvironmentConfigsRequestWithBody generates requests for UpdateRuntimeEnvironmentConfigs with any type of body
+func NewUpdateRuntimeEnvironmentConfigsRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/runtimeEnvironment/%s/configs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRuntimeEnvironmentServicesRequest generates requests for GetRuntimeEnvironmentServices
+func NewGetRuntimeEnvironmentServicesRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/runtimeEnvironment/%s/selectedServices", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateRuntimeEnvironmentServicesRequest calls the generic UpdateRuntimeEnvironmentServices builder with application/json body
+func NewUpdateRuntimeEnvironmentServicesRequest(server string, id string, body UpdateRuntimeEnvironmentServicesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRuntimeEnvironmentServicesRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateRuntimeEnvironmentServicesRequestWithBody generates requests for UpdateRuntimeEnvironmentServices with any type of body
+func NewUpdateRuntimeEnvironmentServicesRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2/runtimeEnvironment/%s/selectedServices", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLoginRequest calls the generic Login builder with application/json body
+func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginRequestWithBody generates requests for Login with any type of body
+func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ma/api/v2/user/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLogoutRequest generates requests for Logout
+func NewLogoutRequest(server string, params *LogoutParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ma/api/v2/user/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "icSessionId", runtime.ParamLocationHeader, params.IcSessionId)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("icSessionId", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// </editor-fold> //////////////////////////////////////////////////////////////
+// <editor-fold desc="client-with-responses" defaultstate="collapsed"> /////////
+
+// ClientWithResponses builds on Client to offer response payloads
+type ClientWithResponses struct {
+	*Client
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...common.ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetActivityLogWithResponse request
+	GetActivityLogWithResponse(ctx context.Context, params *GetActivityLogParams, editors ...common.ClientConfigEditor) (*GetActivityLogResponse, error)
+
+	// GetAgentDetailsWithResponse request
+	GetAgentDetailsWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*GetAgentDetailsResponse, error)
+
+	// GetAgentInstallerInfoWithResponse request
+	GetAgentInstallerInfoWithResponse(ctx context.Context, platform string, editors ...common.ClientConfigEditor) (*GetAgentInstallerInfoResponse, error)
+
+	// GetAgentWithResponse request
+	GetAgentWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*GetAgentResponse, error)
+
+	// UpdateAgentWithBodyWithResponse request with any body
+	UpdateAgentWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateAgentResponse, error)
+
+	UpdateAgentWithResponse(ctx context.Context, id string, body UpdateAgentJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateAgentResponse, error)
+
+	// GetAuditLogWithResponse request
+	GetAuditLogWithResponse(ctx context.Context, params *GetAuditLogParams, editors ...common.ClientConfigEditor) (*GetAuditLogResponse, error)
+
+	// StartJobWithBodyWithResponse request with any body
+	StartJobWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*StartJobResponse, error)
+
+	StartJobWithResponse(ctx context.Context, body StartJobJSONRequestBody, editors ...common.ClientConfigEditor) (*StartJobResponse, error)
+
+	// CreateMappingTaskWithBodyWithResponse request with any body
+	CreateMappingTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*CreateMappingTaskResponse, error)
+
+	CreateMappingTaskWithResponse(ctx context.Context, body CreateMappingTaskJSONRequestBody, editors ...common.ClientConfigEditor) (*CreateMappingTaskResponse, error)
+
+	// DeleteMappingTaskWithResponse request
+	DeleteMappingTaskWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*DeleteMappingTaskResponse, error)
+
+	// GetMappingTaskWithResponse request
+	GetMappingTaskWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*GetMappingTaskResponse, error)
+
+	// UpdateMappingTaskWithBodyWithResponse request with any body
+	UpdateMappingTaskWithBodyWithResponse(ctx context.Context, id string, params *UpdateMappingTaskParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateMappingTaskResponse, error)
+
+	UpdateMappingTaskWithResponse(ctx context.Context, id string, params *UpdateMappingTaskParams, body UpdateMappingTaskJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateMappingTaskResponse, error)
+
+	// GetCurrentOrgWithResponse request
+	GetCurrentOrgWithResponse(ctx context.Context, editors ...common.ClientConfigEditor) (*GetCurrentOrgResponse, error)
+
+	// CreateSubOrgWithBodyWithResponse request with any body
+	CreateSubOrgWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*CreateSubOrgResponse, error)
+
+	CreateSubOrgWithResponse(ctx context.Context, body CreateSubOrgJSONRequestBody, editors ...common.ClientConfigEditor) (*CreateSubOrgResponse, error)
+
+	// DeleteSubOrgWithResponse request
+	DeleteSubOrgWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*DeleteSubOrgResponse, error)
+
+	// GetOrgWithResponse request
+	GetOrgWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*GetOrgResponse, error)
+
+	// UpdateOrgWithBodyWithResponse request with any body
+	UpdateOrgWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateOrgResponse, error)
+
+	UpdateOrgWithResponse(ctx context.Context, id string, body UpdateOrgJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateOrgResponse, error)
+
+	// ListRuntimeEnvironmentsWithResponse request
+	ListRuntimeEnvironmentsWithResponse(ctx context.Context, editors ...common.ClientConfigEditor) (*ListRuntimeEnvironmentsResponse, error)
+
+	// CreateRuntimeEnvironmentWithBodyWithResponse request with any body
+	CreateRuntimeEnvironmentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*CreateRuntimeEnvironmentResponse, error)
+
+	CreateRuntimeEnvironmentWithResponse(ctx context.Context, body CreateRuntimeEnvironmentJSONRequestBody, editors ...common.ClientConfigEditor) (*CreateRuntimeEnvironmentResponse, error)
+
+	// DeleteRuntimeEnvironmentWithResponse request
+	DeleteRuntimeEnvironmentWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*DeleteRuntimeEnvironmentResponse, error)
+
+	// GetRuntimeEnvironmentWithResponse request
+	GetRuntimeEnvironmentWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*GetRuntimeEnvironmentResponse, error)
+
+	// UpdateRuntimeEnvironmentWithBodyWithResponse request with any body
+	UpdateRuntimeEnvironmentWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateRuntimeEnvironmentResponse, error)
+
+	UpdateRuntimeEnvironmentWithResponse(ctx context.Context, id string, body UpdateRuntimeEnvironmentJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateRuntimeEnvironmentResponse, error)
+
+	// GetRuntimeEnvironmentConfigsWithResponse request
+	GetRuntimeEnvironmentConfigsWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*GetRuntimeEnvironmentConfigsResponse, error)
+
+	// UpdateRuntimeEnvironmentConfigsWithBodyWithResponse request with any body
+	UpdateRuntimeEnvironmentConfigsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateRuntimeEnvironmentConfigsResponse, error)
+
+	UpdateRuntimeEnvironmentConfigsWithResponse(ctx context.Context, id string, body UpdateRuntimeEnvironmentConfigsJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateRuntimeEnvironmentConfigsResponse, error)
+
+	// GetRuntimeEnvironmentServicesWithResponse request
+	GetRuntimeEnvironmentServicesWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*GetRuntimeEnvironmentServicesResponse, error)
+
+	// UpdateRuntimeEnvironmentServicesWithBodyWithResponse request with any body
+	UpdateRuntimeEnvironmentServicesWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateRuntimeEnvironmentServicesResponse, error)
+
+	UpdateRuntimeEnvironmentServicesWithResponse(ctx context.Context, id string, body UpdateRuntimeEnvironmentServicesJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateRuntimeEnvironmentServicesResponse, error)
+
+	// LoginWithBodyWithResponse request with any body
+	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*LoginResponse, error)
+
+	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, editors ...common.ClientConfigEditor) (*LoginResponse, error)
+
+	// LogoutWithResponse request
+	LogoutWithResponse(ctx context.Context, params *LogoutParams, editors ...common.ClientConfigEditor) (*LogoutResponse, error)
+}
+
+type GetActivityLogResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *[]ActivityLogEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r GetActivityLogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetActivityLogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r GetActivityLogResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r GetActivityLogResponse) BodyData() []byte {
+	return r.Body
+}
+
+type GetAgentDetailsResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *AgentDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAgentDetailsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAgentDetailsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r GetAgentDetailsResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r GetAgentDetailsResponse) BodyData() []byte {
+	return r.Body
+}
+
+type GetAgentInstallerInfoResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *GetAgentInstallerInfoResponseBody
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAgentInstallerInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAgentInstallerInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r GetAgentInstallerInfoResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r GetAgentInstallerInfoResponse) BodyData() []byte {
+	return r.Body
+}
+
+type GetAgentResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *Agent
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAgentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAgentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r GetAgentResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r GetAgentResponse) BodyData() []byte {
+	return r.Body
+}
+
+type UpdateAgentResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *Agent
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAgentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAgentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r UpdateAgentResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r UpdateAgentResponse) BodyData() []byte {
+	return r.Body
+}
+
+type GetAuditLogResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *[]AuditLogEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuditLogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuditLogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r GetAuditLogResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r GetAuditLogResponse) BodyData() []byte {
+	return r.Body
+}
+
+type StartJobResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *JobResponseBody
+}
+
+// Status returns HTTPResponse.Status
+func (r StartJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r StartJobResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r StartJobResponse) BodyData() []byte {
+	return r.Body
+}
+
+type CreateMappingTaskResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *MappingTask
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateMappingTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateMappingTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r CreateMappingTaskResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r CreateMappingTaskResponse) BodyData() []byte {
+	return r.Body
+}
+
+type DeleteMappingTaskResponse struct {
+	common.IdmcClientResponse[N400]
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMappingTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMappingTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r DeleteMappingTaskResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r DeleteMappingTaskResponse) BodyData() []byte {
+	return r.Body
+}
+
+type GetMappingTaskResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *MappingTask
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMappingTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMappingTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r GetMappingTaskResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r GetMappingTaskResponse) BodyData() []byte {
+	return r.Body
+}
+
+type UpdateMappingTaskResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *MappingTask
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMappingTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMappingTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r UpdateMappingTaskResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r UpdateMappingTaskResponse) BodyData() []byte {
+	return r.Body
+}
+
+type GetCurrentOrgResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *Org
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCurrentOrgResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCurrentOrgResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r GetCurrentOrgResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r GetCurrentOrgResponse) BodyData() []byte {
+	return r.Body
+}
+
+type CreateSubOrgResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *Org
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSubOrgResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSubOrgResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r CreateSubOrgResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r CreateSubOrgResponse) BodyData() []byte {
+	return r.Body
+}
+
+type DeleteSubOrgResponse struct {
+	common.IdmcClientResponse[N400]
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSubOrgResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSubOrgResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r DeleteSubOrgResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r DeleteSubOrgResponse) BodyData() []byte {
+	return r.Body
+}
+
+type GetOrgResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *Org
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrgResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrgResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r GetOrgResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r GetOrgResponse) BodyData() []byte {
+	return r.Body
+}
+
+type UpdateOrgResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *Org
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrgResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrgResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r UpdateOrgResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r UpdateOrgResponse) BodyData() []byte {
+	return r.Body
+}
+
+type ListRuntimeEnvironmentsResponse struct {
+	common.IdmcClientResponse[N400]
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRuntimeEnvironmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRuntimeEnvironmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r ListRuntimeEnvironmentsResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r ListRuntimeEnvironmentsResponse) BodyData() []byte {
+	return r.Body
+}
+
+type CreateRuntimeEnvironmentResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *RuntimeEnvironment
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRuntimeEnvironmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRuntimeEnvironmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r CreateRuntimeEnvironmentResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r CreateRuntimeEnvironmentResponse) BodyData() []byte {
+	return r.Body
+}
+
+type DeleteRuntimeEnvironmentResponse struct {
+	common.IdmcClientResponse[N400]
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRuntimeEnvironmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRuntimeEnvironmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r DeleteRuntimeEnvironmentResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r DeleteRuntimeEnvironmentResponse) BodyData() []byte {
+	return r.Body
+}
+
+type GetRuntimeEnvironmentResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *RuntimeEnvironment
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRuntimeEnvironmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRuntimeEnvironmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r GetRuntimeEnvironmentResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r GetRuntimeEnvironmentResponse) BodyData() []byte {
+	return r.Body
+}
+
+type UpdateRuntimeEnvironmentResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *RuntimeEnvironment
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateRuntimeEnvironmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateRuntimeEnvironmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r UpdateRuntimeEnvironmentResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r UpdateRuntimeEnvironmentResponse) BodyData() []byte {
+	return r.Body
+}
+
+type GetRuntimeEnvironmentConfigsResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *AgentConfigList
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRuntimeEnvironmentConfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRuntimeEnvironmentConfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r GetRuntimeEnvironmentConfigsResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r GetRuntimeEnvironmentConfigsResponse) BodyData() []byte {
+	return r.Body
+}
+
+type UpdateRuntimeEnvironmentConfigsResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *AgentConfigList
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateRuntimeEnvironmentConfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateRuntimeEnvironmentConfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r UpdateRuntimeEnvironmentConfigsResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r UpdateRuntimeEnvironmentConfigsResponse) BodyData() []byte {
+	return r.Body
+}
+
+type GetRuntimeEnvironmentServicesResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *RuntimeEnvironmentServiceList
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRuntimeEnvironmentServicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRuntimeEnvironmentServicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r GetRuntimeEnvironmentServicesResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r GetRuntimeEnvironmentServicesResponse) BodyData() []byte {
+	return r.Body
+}
+
+type UpdateRuntimeEnvironmentServicesResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *RuntimeEnvironmentServiceList
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateRuntimeEnvironmentServicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateRuntimeEnvironmentServicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r UpdateRuntimeEnvironmentServicesResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r UpdateRuntimeEnvironmentServicesResponse) BodyData() []byte {
+	return r.Body
+}
+
+type LoginResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *LoginResponseBody
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r LoginResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r LoginResponse) BodyData() []byte {
+	return r.Body
+}
+
+type LogoutResponse struct {
+	common.IdmcClientResponse[N400]
+}
+
+// Status returns HTTPResponse.Status
+func (r LogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r LogoutResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r LogoutResponse) BodyData() []byte {
+	return r.Body
+}
+
+// GetActivityLogWithResponse request returning *GetActivityLogResponse
+func (c *ClientWithResponses) GetActivityLogWithResponse(ctx context.Context, params *GetActivityLogParams, editors ...common.ClientConfigEditor) (*GetActivityLogResponse, error) {
+	rsp, err := c.GetActivityLog(ctx, params, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseGetActivityLogResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// GetAgentDetailsWithResponse request returning *GetAgentDetailsResponse
+func (c *ClientWithResponses) GetAgentDetailsWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*GetAgentDetailsResponse, error) {
+	rsp, err := c.GetAgentDetails(ctx, id, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseGetAgentDetailsResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// GetAgentInstallerInfoWithResponse request returning *GetAgentInstallerInfoResponse
+func (c *ClientWithResponses) GetAgentInstallerInfoWithResponse(ctx context.Context, platform string, editors ...common.ClientConfigEditor) (*GetAgentInstallerInfoResponse, error) {
+	rsp, err := c.GetAgentInstallerInfo(ctx, platform, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseGetAgentInstallerInfoResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// GetAgentWithResponse request returning *GetAgentResponse
+func (c *ClientWithResponses) GetAgentWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*GetAgentResponse, error) {
+	rsp, err := c.GetAgent(ctx, id, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseGetAgentResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// UpdateAgentWithBodyWithResponse request with arbitrary body returning *UpdateAgentResponse
+func (c *ClientWithResponses) UpdateAgentWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateAgentResponse, error) {
+	rsp, err := c.UpdateAgentWithBody(ctx, id, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateAgentResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) UpdateAgentWithResponse(ctx context.Context, id string, body UpdateAgentJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateAgentResponse, error) {
+	rsp, err := c.UpdateAgent(ctx, id, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateAgentResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// GetAuditLogWithResponse request returning *GetAuditLogResponse
+func (c *ClientWithResponses) GetAuditLogWithResponse(ctx context.Context, params *GetAuditLogParams, editors ...common.ClientConfigEditor) (*GetAuditLogResponse, error) {
+	rsp, err := c.GetAuditLog(ctx, params, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseGetAuditLogResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// StartJobWithBodyWithResponse request with arbitrary body returning *StartJobResponse
+func (c *ClientWithResponses) StartJobWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*StartJobResponse, error) {
+	rsp, err := c.StartJobWithBody(ctx, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseStartJobResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) StartJobWithResponse(ctx context.Context, body StartJobJSONRequestBody, editors ...common.ClientConfigEditor) (*StartJobResponse, error) {
+	rsp, err := c.StartJob(ctx, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseStartJobResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// CreateMappingTaskWithBodyWithResponse request with arbitrary body returning *CreateMappingTaskResponse
+func (c *ClientWithResponses) CreateMappingTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*CreateMappingTaskResponse, error) {
+	rsp, err := c.CreateMappingTaskWithBody(ctx, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseCreateMappingTaskResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) CreateMappingTaskWithResponse(ctx context.Context, body CreateMappingTaskJSONRequestBody, editors ...common.ClientConfigEditor) (*CreateMappingTaskResponse, error) {
+	rsp, err := c.CreateMappingTask(ctx, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseCreateMappingTaskResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// DeleteMappingTaskWithResponse request returning *DeleteMappingTaskResponse
+func (c *ClientWithResponses) DeleteMappingTaskWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*DeleteMappingTaskResponse, error) {
+	rsp, err := c.DeleteMappingTask(ctx, id, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseDeleteMappingTaskResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// GetMappingTaskWithResponse request returning *GetMappingTaskResponse
+func (c *ClientWithResponses) GetMappingTaskWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*GetMappingTaskResponse, error) {
+	rsp, err := c.GetMappingTask(ctx, id, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseGetMappingTaskResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// UpdateMappingTaskWithBodyWithResponse request with arbitrary body returning *UpdateMappingTaskResponse
+func (c *ClientWithResponses) UpdateMappingTaskWithBodyWithResponse(ctx context.Context, id string, params *UpdateMappingTaskParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateMappingTaskResponse, error) {
+	rsp, err := c.UpdateMappingTaskWithBody(ctx, id, params, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateMappingTaskResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) UpdateMappingTaskWithResponse(ctx context.Context, id string, params *UpdateMappingTaskParams, body UpdateMappingTaskJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateMappingTaskResponse, error) {
+	rsp, err := c.UpdateMappingTask(ctx, id, params, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateMappingTaskResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// GetCurrentOrgWithResponse request returning *GetCurrentOrgResponse
+func (c *ClientWithResponses) GetCurrentOrgWithResponse(ctx context.Context, editors ...common.ClientConfigEditor) (*GetCurrentOrgResponse, error) {
+	rsp, err := c.GetCurrentOrg(ctx, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseGetCurrentOrgResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// CreateSubOrgWithBodyWithResponse request with arbitrary body returning *CreateSubOrgResponse
+func (c *ClientWithResponses) CreateSubOrgWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*CreateSubOrgResponse, error) {
+	rsp, err := c.CreateSubOrgWithBody(ctx, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseCreateSubOrgResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) CreateSubOrgWithResponse(ctx context.Context, body CreateSubOrgJSONRequestBody, editors ...common.ClientConfigEditor) (*CreateSubOrgResponse, error) {
+	rsp, err := c.CreateSubOrg(ctx, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseCreateSubOrgResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// DeleteSubOrgWithResponse request returning *DeleteSubOrgResponse
+func (c *ClientWithResponses) DeleteSubOrgWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*DeleteSubOrgResponse, error) {
+	rsp, err := c.DeleteSubOrg(ctx, id, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseDeleteSubOrgResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// GetOrgWithResponse request returning *GetOrgResponse
+func (c *ClientWithResponses) GetOrgWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*GetOrgResponse, error) {
+	rsp, err := c.GetOrg(ctx, id, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseGetOrgResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// UpdateOrgWithBodyWithResponse request with arbitrary body returning *UpdateOrgResponse
+func (c *ClientWithResponses) UpdateOrgWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateOrgResponse, error) {
+	rsp, err := c.UpdateOrgWithBody(ctx, id, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateOrgResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) UpdateOrgWithResponse(ctx context.Context, id string, body UpdateOrgJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateOrgResponse, error) {
+	rsp, err := c.UpdateOrg(ctx, id, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateOrgResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// ListRuntimeEnvironmentsWithResponse request returning *ListRuntimeEnvironmentsResponse
+func (c *ClientWithResponses) ListRuntimeEnvironmentsWithResponse(ctx context.Context, editors ...common.ClientConfigEditor) (*ListRuntimeEnvironmentsResponse, error) {
+	rsp, err := c.ListRuntimeEnvironments(ctx, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseListRuntimeEnvironmentsResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// CreateRuntimeEnvironmentWithBodyWithResponse request with arbitrary body returning *CreateRuntimeEnvironmentResponse
+func (c *ClientWithResponses) CreateRuntimeEnvironmentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*CreateRuntimeEnvironmentResponse, error) {
+	rsp, err := c.CreateRuntimeEnvironmentWithBody(ctx, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseCreateRuntimeEnvironmentResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) CreateRuntimeEnvironmentWithResponse(ctx context.Context, body CreateRuntimeEnvironmentJSONRequestBody, editors ...common.ClientConfigEditor) (*CreateRuntimeEnvironmentResponse, error) {
+	rsp, err := c.CreateRuntimeEnvironment(ctx, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseCreateRuntimeEnvironmentResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// DeleteRuntimeEnvironmentWithResponse request returning *DeleteRuntimeEnvironmentResponse
+func (c *ClientWithResponses) DeleteRuntimeEnvironmentWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*DeleteRuntimeEnvironmentResponse, error) {
+	rsp, err := c.DeleteRuntimeEnvironment(ctx, id, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseDeleteRuntimeEnvironmentResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// GetRuntimeEnvironmentWithResponse request returning *GetRuntimeEnvironmentResponse
+func (c *ClientWithResponses) GetRuntimeEnvironmentWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*GetRuntimeEnvironmentResponse, error) {
+	rsp, err := c.GetRuntimeEnvironment(ctx, id, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseGetRuntimeEnvironmentResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// UpdateRuntimeEnvironmentWithBodyWithResponse request with arbitrary body returning *UpdateRuntimeEnvironmentResponse
+func (c *ClientWithResponses) UpdateRuntimeEnvironmentWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateRuntimeEnvironmentResponse, error) {
+	rsp, err := c.UpdateRuntimeEnvironmentWithBody(ctx, id, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateRuntimeEnvironmentResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) UpdateRuntimeEnvironmentWithResponse(ctx context.Context, id string, body UpdateRuntimeEnvironmentJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateRuntimeEnvironmentResponse, error) {
+	rsp, err := c.UpdateRuntimeEnvironment(ctx, id, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateRuntimeEnvironmentResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// GetRuntimeEnvironmentConfigsWithResponse request returning *GetRuntimeEnvironmentConfigsResponse
+func (c *ClientWithResponses) GetRuntimeEnvironmentConfigsWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*GetRuntimeEnvironmentConfigsResponse, error) {
+	rsp, err := c.GetRuntimeEnvironmentConfigs(ctx, id, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseGetRuntimeEnvironmentConfigsResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// UpdateRuntimeEnvironmentConfigsWithBodyWithResponse request with arbitrary body returning *UpdateRuntimeEnvironmentConfigsResponse
+func (c *ClientWithResponses) UpdateRuntimeEnvironmentConfigsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateRuntimeEnvironmentConfigsResponse, error) {
+	rsp, err := c.UpdateRuntimeEnvironmentConfigsWithBody(ctx, id, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateRuntimeEnvironmentConfigsResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) UpdateRuntimeEnvironmentConfigsWithResponse(ctx context.Context, id string, body UpdateRuntimeEnvironmentConfigsJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateRuntimeEnvironmentConfigsResponse, error) {
+	rsp, err := c.UpdateRuntimeEnvironmentConfigs(ctx, id, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateRuntimeEnvironmentConfigsResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// GetRuntimeEnvironmentServicesWithResponse request returning *GetRuntimeEnvironmentServicesResponse
+func (c *ClientWithResponses) GetRuntimeEnvironmentServicesWithResponse(ctx context.Context, id string, editors ...common.ClientConfigEditor) (*GetRuntimeEnvironmentServicesResponse, error) {
+	rsp, err := c.GetRuntimeEnvironmentServices(ctx, id, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseGetRuntimeEnvironmentServicesResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// UpdateRuntimeEnvironmentServicesWithBodyWithResponse request with arbitrary body returning *UpdateRuntimeEnvironmentServicesResponse
+func (c *ClientWithResponses) UpdateRuntimeEnvironmentServicesWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateRuntimeEnvironmentServicesResponse, error) {
+	rsp, err := c.UpdateRuntimeEnvironmentServicesWithBody(ctx, id, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateRuntimeEnvironmentServicesResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) UpdateRuntimeEnvironmentServicesWithResponse(ctx context.Context, id string, body UpdateRuntimeEnvironmentServicesJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateRuntimeEnvironmentServicesResponse, error) {
+	rsp, err := c.UpdateRuntimeEnvironmentServices(ctx, id, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateRuntimeEnvironmentServicesResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
+func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*LoginResponse, error) {
+	rsp, err := c.LoginWithBody(ctx, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseLoginResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, editors ...common.ClientConfigEditor) (*LoginResponse, error) {
+	rsp, err := c.Login(ctx, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseLoginResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// LogoutWithResponse request returning *LogoutResponse
+func (c *ClientWithResponses) LogoutWithResponse(ctx context.Context, params *LogoutParams, editors ...common.ClientConfigEditor) (*LogoutResponse, error) {
+	rsp, err := c.Logout(ctx, params, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseLogoutResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
 	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
 		return nil, err
 	}
-	return apiRes, nil
+	return apiRes, nil
+}
+
+// ParseGetActivityLogResponse parses an HTTP response from a GetActivityLogWithResponse call
+func ParseGetActivityLogResponse(rsp *http.Response) (*GetActivityLogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetActivityLogResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ActivityLogEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAgentDetailsResponse parses an HTTP response from a GetAgentDetailsWithResponse call
+func ParseGetAgentDetailsResponse(rsp *http.Response) (*GetAgentDetailsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAgentDetailsResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AgentDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAgentInstallerInfoResponse parses an HTTP response from a GetAgentInstallerInfoWithResponse call
+func ParseGetAgentInstallerInfoResponse(rsp *http.Response) (*GetAgentInstallerInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAgentInstallerInfoResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetAgentInstallerInfoResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAgentResponse parses an HTTP response from a GetAgentWithResponse call
+func ParseGetAgentResponse(rsp *http.Response) (*GetAgentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAgentResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Agent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateAgentResponse parses an HTTP response from a UpdateAgentWithResponse call
+func ParseUpdateAgentResponse(rsp *http.Response) (*UpdateAgentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateAgentResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Agent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuditLogResponse parses an HTTP response from a GetAuditLogWithResponse call
+func ParseGetAuditLogResponse(rsp *http.Response) (*GetAuditLogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuditLogResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AuditLogEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStartJobResponse parses an HTTP response from a StartJobWithResponse call
+func ParseStartJobResponse(rsp *http.Response) (*StartJobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartJobResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JobResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateMappingTaskResponse parses an HTTP response from a CreateMappingTaskWithResponse call
+func ParseCreateMappingTaskResponse(rsp *http.Response) (*CreateMappingTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateMappingTaskResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MappingTask
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMappingTaskResponse parses an HTTP response from a DeleteMappingTaskWithResponse call
+func ParseDeleteMappingTaskResponse(rsp *http.Response) (*DeleteMappingTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMappingTaskResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMappingTaskResponse parses an HTTP response from a GetMappingTaskWithResponse call
+func ParseGetMappingTaskResponse(rsp *http.Response) (*GetMappingTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMappingTaskResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MappingTask
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateMappingTaskResponse parses an HTTP response from a UpdateMappingTaskWithResponse call
+func ParseUpdateMappingTaskResponse(rsp *http.Response) (*UpdateMappingTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateMappingTaskResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MappingTask
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCurrentOrgResponse parses an HTTP response from a GetCurrentOrgWithResponse call
+func ParseGetCurrentOrgResponse(rsp *http.Response) (*GetCurrentOrgResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCurrentOrgResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Org
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSubOrgResponse parses an HTTP response from a CreateSubOrgWithResponse call
+func ParseCreateSubOrgResponse(rsp *http.Response) (*CreateSubOrgResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSubOrgResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Org
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSubOrgResponse parses an HTTP response from a DeleteSubOrgWithResponse call
+func ParseDeleteSubOrgResponse(rsp *http.Response) (*DeleteSubOrgResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSubOrgResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrgResponse parses an HTTP response from a GetOrgWithResponse call
+func ParseGetOrgResponse(rsp *http.Response) (*GetOrgResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrgResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Org
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateOrgResponse parses an HTTP response from a UpdateOrgWithResponse call
+func ParseUpdateOrgResponse(rsp *http.Response) (*UpdateOrgResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateOrgResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Org
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRuntimeEnvironmentsResponse parses an HTTP response from a ListRuntimeEnvironmentsWithResponse call
+func ParseListRuntimeEnvironmentsResponse(rsp *http.Response) (*ListRuntimeEnvironmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRuntimeEnvironmentsResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
 }
 
-// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
-func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*LoginResponse, error) {
-	rsp, err := c.LoginWithBody(ctx, contentType, body, editors...)
-	if err != nil {
-		return nil, err
-	}
-	apiRes, err := ParseLoginResponse(rsp)
+// ParseCreateRuntimeEnvironmentResponse parses an HTTP response from a CreateRuntimeEnvironmentWithResponse call
+func ParseCreateRuntimeEnvironmentResponse(rsp *http.Response) (*CreateRuntimeEnvironmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
-		return nil, err
+
+	response := &CreateRuntimeEnvironmentResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RuntimeEnvironment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
-	return apiRes, nil
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, editors ...common.ClientConfigEditor) (*LoginResponse, error) {
-	rsp, err := c.Login(ctx, body, editors...)
-	if err != nil {
-		return nil, err
-	}
-	apiRes, err := ParseLoginResponse(rsp)
+// ParseDeleteRuntimeEnvironmentResponse parses an HTTP response from a DeleteRuntimeEnvironmentWithResponse call
+func ParseDeleteRuntimeEnvironmentResponse(rsp *http.Response) (*DeleteRuntimeEnvironmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
-		return nil, err
+
+	response := &DeleteRuntimeEnvironmentResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
-	return apiRes, nil
+
+	return response, nil
 }
 
-// ParseGetAgentInstallerInfoResponse parses an HTTP response from a GetAgentInstallerInfoWithResponse call
-func ParseGetAgentInstallerInfoResponse(rsp *http.Response) (*GetAgentInstallerInfoResponse, error) {
+// ParseGetRuntimeEnvironmentResponse parses an HTTP response from a GetRuntimeEnvironmentWithResponse call
+func ParseGetRuntimeEnvironmentResponse(rsp *http.Response) (*GetRuntimeEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAgentInstallerInfoResponse{
-		ClientResponse: common.ClientResponse{
-			Body:         bodyBytes,
-			HTTPResponse: rsp,
-		},
-	}
+	response := &GetRuntimeEnvironmentResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetAgentInstallerInfoResponseBody
+		var dest RuntimeEnvironment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1352,22 +5715,26 @@ func ParseGetAgentInstallerInfoResponse(rsp *http.Response) (*GetAgentInstallerI
 	return response, nil
 }
 
-// ParseListRuntimeEnvironmentsResponse parses an HTTP response from a ListRuntimeEnvironmentsWithResponse call
-func ParseListRuntimeEnvironmentsResponse(rsp *http.Response) (*ListRuntimeEnvironmentsResponse, error) {
+// ParseUpdateRuntimeEnvironmentResponse parses an HTTP response from a UpdateRuntimeEnvironmentWithResponse call
+func ParseUpdateRuntimeEnvironmentResponse(rsp *http.Response) (*UpdateRuntimeEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListRuntimeEnvironmentsResponse{
-		ClientResponse: common.ClientResponse{
-			Body:         bodyBytes,
-			HTTPResponse: rsp,
-		},
-	}
+	response := &UpdateRuntimeEnvironmentResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RuntimeEnvironment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1422,24 +5789,21 @@ func ParseListRuntimeEnvironmentsResponse(rsp *http.Response) (*ListRuntimeEnvir
 	return response, nil
 }
 
-// ParseCreateRuntimeEnvironmentResponse parses an HTTP response from a CreateRuntimeEnvironmentWithResponse call
-func ParseCreateRuntimeEnvironmentResponse(rsp *http.Response) (*CreateRuntimeEnvironmentResponse, error) {
+// ParseGetRuntimeEnvironmentConfigsResponse parses an HTTP response from a GetRuntimeEnvironmentConfigsWithResponse call
+func ParseGetRuntimeEnvironmentConfigsResponse(rsp *http.Response) (*GetRuntimeEnvironmentConfigsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateRuntimeEnvironmentResponse{
-		ClientResponse: common.ClientResponse{
-			Body:         bodyBytes,
-			HTTPResponse: rsp,
-		},
-	}
+	response := &GetRuntimeEnvironmentConfigsResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RuntimeEnvironment
+		var dest AgentConfigList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1499,22 +5863,26 @@ func ParseCreateRuntimeEnvironmentResponse(rsp *http.Response) (*CreateRuntimeEn
 	return response, nil
 }
 
-// ParseDeleteRuntimeEnvironmentResponse parses an HTTP response from a DeleteRuntimeEnvironmentWithResponse call
-func ParseDeleteRuntimeEnvironmentResponse(rsp *http.Response) (*DeleteRuntimeEnvironmentResponse, error) {
+// ParseUpdateRuntimeEnvironmentConfigsResponse parses an HTTP response from a UpdateRuntimeEnvironmentConfigsWithResponse call
+func ParseUpdateRuntimeEnvironmentConfigsResponse(rsp *http.Response) (*UpdateRuntimeEnvironmentConfigsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteRuntimeEnvironmentResponse{
-		ClientResponse: common.ClientResponse{
-			Body:         bodyBytes,
-			HTTPResponse: rsp,
-		},
-	}
+	response := &UpdateRuntimeEnvironmentConfigsResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AgentConfigList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1569,24 +5937,21 @@ func ParseDeleteRuntimeEnvironmentResponse(rsp *http.Response) (*DeleteRuntimeEn
 	return response, nil
 }
 
-// ParseGetRuntimeEnvironmentResponse parses an HTTP response from a GetRuntimeEnvironmentWithResponse call
-func ParseGetRuntimeEnvironmentResponse(rsp *http.Response) (*GetRuntimeEnvironmentResponse, error) {
+// ParseGetRuntimeEnvironmentServicesResponse parses an HTTP response from a GetRuntimeEnvironmentServicesWithResponse call
+func ParseGetRuntimeEnvironmentServicesResponse(rsp *http.Response) (*GetRuntimeEnvironmentServicesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRuntimeEnvironmentResponse{
-		ClientResponse: common.ClientResponse{
-			Body:         bodyBytes,
-			HTTPResponse: rsp,
-		},
-	}
+	response := &GetRuntimeEnvironmentServicesResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RuntimeEnvironment
+		var dest RuntimeEnvironmentServiceList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1646,24 +6011,21 @@ func ParseGetRuntimeEnvironmentResponse(rsp *http.Response) (*GetRuntimeEnvironm
 	return response, nil
 }
 
-// ParseUpdateRuntimeEnvironmentResponse parses an HTTP response from a UpdateRuntimeEnvironmentWithResponse call
-func ParseUpdateRuntimeEnvironmentResponse(rsp *http.Response) (*UpdateRuntimeEnvironmentResponse, error) {
+// ParseUpdateRuntimeEnvironmentServicesResponse parses an HTTP response from a UpdateRuntimeEnvironmentServicesWithResponse call
+func ParseUpdateRuntimeEnvironmentServicesResponse(rsp *http.Response) (*UpdateRuntimeEnvironmentServicesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateRuntimeEnvironmentResponse{
-		ClientResponse: common.ClientResponse{
-			Body:         bodyBytes,
-			HTTPResponse: rsp,
-		},
-	}
+	response := &UpdateRuntimeEnvironmentServicesResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RuntimeEnvironment
+		var dest RuntimeEnvironmentServiceList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1731,12 +6093,9 @@ func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
 		return nil, err
 	}
 
-	response := &LoginResponse{
-		ClientResponse: common.ClientResponse{
-			Body:         bodyBytes,
-			HTTPResponse: rsp,
-		},
-	}
+	response := &LoginResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
@@ -1800,4 +6159,71 @@ func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
 	return response, nil
 }
 
+// ParseLogoutResponse parses an HTTP response from a LogoutWithResponse call
+func ParseLogoutResponse(rsp *http.Response) (*LogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogoutResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
 // </editor-fold> //////////////////////////////////////////////////////////////
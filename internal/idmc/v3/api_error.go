@@ -0,0 +1,24 @@
+package v3
+
+import (
+	"terraform-provider-idmc/internal/idmc/common"
+)
+
+var _ common.ApiErrorBody = ApiErrorResponseBody{}
+
+func (b ApiErrorResponseBody) AsApiError() common.ApiError {
+	apiErr := common.ApiError{
+		RequestId: b.Error.RequestId,
+		Code:      b.Error.Code,
+		Message:   b.Error.Message,
+	}
+	if b.Error.Details != nil {
+		for _, detail := range *b.Error.Details {
+			apiErr.Details = append(apiErr.Details, common.ApiErrorDetail{
+				Code:    detail.Code,
+				Message: detail.Message,
+			})
+		}
+	}
+	return apiErr
+}
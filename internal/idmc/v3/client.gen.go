@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"io"
 	"net/http"
@@ -23,12 +24,26 @@ import (
 // </editor-fold> //////////////////////////////////////////////////////////////
 // <editor-fold desc="constants" defaultstate="collapsed"> /////////////////////
 
+// Defines values for AgentServiceRequestBodyServiceAction.
+const (
+	AgentServiceRequestBodyServiceActionStart AgentServiceRequestBodyServiceAction = "start"
+	AgentServiceRequestBodyServiceActionStop  AgentServiceRequestBodyServiceAction = "stop"
+)
+
 // Defines values for LoginResponseBodyUserInfoStatus.
 const (
 	LoginResponseBodyUserInfoStatusActive   LoginResponseBodyUserInfoStatus = "Active"
 	LoginResponseBodyUserInfoStatusInactive LoginResponseBodyUserInfoStatus = "Inactive"
 )
 
+// Defines values for PublishStatusState.
+const (
+	PublishStatusStateFAILED     PublishStatusState = "FAILED"
+	PublishStatusStateNOTSTARTED PublishStatusState = "NOT_STARTED"
+	PublishStatusStateRUNNING    PublishStatusState = "RUNNING"
+	PublishStatusStateSUCCESSFUL PublishStatusState = "SUCCESSFUL"
+)
+
 // Defines values for RolePrivilegeItemStatus.
 const (
 	RolePrivilegeItemStatusDefault    RolePrivilegeItemStatus = "Default"
@@ -50,6 +65,27 @@ const (
 
 // </editor-fold> //////////////////////////////////////////////////////////////
 
+// AgentServiceRequestBody defines model for agentServiceRequestBody.
+type AgentServiceRequestBody struct {
+	// AgentId ID of the Secure Agent the service runs on.
+	AgentId string `json:"agentId"`
+
+	// ServiceAction What to do with the service.
+	ServiceAction AgentServiceRequestBodyServiceAction `json:"serviceAction"`
+
+	// ServiceName Name of the service, such as 'Data Integration Server'.
+	ServiceName string `json:"serviceName"`
+}
+
+// AgentServiceRequestBodyServiceAction What to do with the service.
+type AgentServiceRequestBodyServiceAction string
+
+// AgentServiceResponseBody defines model for agentServiceResponseBody.
+type AgentServiceResponseBody struct {
+	// Message Outcome of the request.
+	Message *string `json:"message,omitempty"`
+}
+
 // ApiError defines model for apiError.
 type ApiError struct {
 	Code         string            `json:"code"`
@@ -169,6 +205,9 @@ type GetRolesResponseBodyItem struct {
 
 // LoginRequestBody defines model for loginRequestBody.
 type LoginRequestBody struct {
+	// OrgId ID of a sub-organization of the user's organization to log in to, instead of the user's own.
+	OrgId *string `json:"orgId,omitempty"`
+
 	// Password Informatica Intelligent Cloud Services password.
 	Password string `json:"password"`
 
@@ -222,6 +261,111 @@ type LoginResponseBodyUserInfo struct {
 // LoginResponseBodyUserInfoStatus Status of the user.
 type LoginResponseBodyUserInfoStatus string
 
+// LookupRequestBody defines model for lookupRequestBody.
+type LookupRequestBody struct {
+	Objects []ObjectRef `json:"objects"`
+}
+
+// LookupResponseBody defines model for lookupResponseBody.
+type LookupResponseBody struct {
+	Objects *[]ObjectInfo `json:"objects,omitempty"`
+}
+
+// ObjectInfo defines model for objectInfo.
+type ObjectInfo struct {
+	// Description Description of the asset.
+	Description *string `json:"description,omitempty"`
+
+	// Id Global unique identifier of the asset.
+	Id *string `json:"id,omitempty"`
+
+	// Path Path of the asset, such as 'Project/Folder/Asset'.
+	Path *string `json:"path,omitempty"`
+
+	// Type Type of the asset.
+	Type *string `json:"type,omitempty"`
+
+	// UpdateTime When the asset was last updated.
+	UpdateTime *string `json:"updateTime,omitempty"`
+}
+
+// ObjectRef Identifies an asset by either id, or path and type.
+type ObjectRef struct {
+	// Id Global unique identifier of the asset.
+	Id *string `json:"id,omitempty"`
+
+	// Path Path of the asset, such as 'Project/Folder/Asset'.
+	Path *string `json:"path,omitempty"`
+
+	// Type Type of the asset, such as 'TASKFLOW' or 'MTT'.
+	Type *string `json:"type,omitempty"`
+}
+
+// PublishJob defines model for publishJob.
+type PublishJob struct {
+	// Id ID of the publish job.
+	Id      *string             `json:"id,omitempty"`
+	Objects *[]PublishJobObject `json:"objects,omitempty"`
+	Status  *PublishStatus      `json:"status,omitempty"`
+}
+
+// PublishJobObject defines model for publishJobObject.
+type PublishJobObject struct {
+	// Description Description of the asset.
+	Description *string `json:"description,omitempty"`
+
+	// Id Global unique identifier of the asset.
+	Id *string `json:"id,omitempty"`
+
+	// Path Path of the asset, such as 'Project/Folder/Asset'.
+	Path *string `json:"path,omitempty"`
+
+	// ServiceUrl The url the published asset can be invoked on.
+	ServiceUrl *string        `json:"serviceUrl,omitempty"`
+	Status     *PublishStatus `json:"status,omitempty"`
+
+	// Type Type of the asset.
+	Type *string `json:"type,omitempty"`
+
+	// UpdateTime When the asset was last updated.
+	UpdateTime *string `json:"updateTime,omitempty"`
+}
+
+// PublishObject defines model for publishObject.
+type PublishObject struct {
+	// Id Global unique identifier of the asset.
+	Id *string `json:"id,omitempty"`
+
+	// Path Path of the asset, such as 'Project/Folder/Asset'.
+	Path *string `json:"path,omitempty"`
+
+	// RunAsUser Name of the user the published asset runs as, overriding the publishing user.
+	RunAsUser *string `json:"runAsUser,omitempty"`
+
+	// RuntimeEnvironmentId ID of the runtime environment to publish the asset to, overriding the one it was designed with.
+	RuntimeEnvironmentId *string `json:"runtimeEnvironmentId,omitempty"`
+
+	// Type Type of the asset, such as 'TASKFLOW' or 'MTT'.
+	Type *string `json:"type,omitempty"`
+}
+
+// PublishRequestBody defines model for publishRequestBody.
+type PublishRequestBody struct {
+	Objects []PublishObject `json:"objects"`
+}
+
+// PublishStatus defines model for publishStatus.
+type PublishStatus struct {
+	// Message Details of the state, such as why the asset failed to publish.
+	Message *string `json:"message,omitempty"`
+
+	// State State of the job, or of an individual asset within the job.
+	State *PublishStatusState `json:"state,omitempty"`
+}
+
+// PublishStatusState State of the job, or of an individual asset within the job.
+type PublishStatusState string
+
 // RoleInfo defines model for roleInfo.
 type RoleInfo struct {
 	// CreateTime Date and time the role was created.
@@ -293,6 +437,67 @@ type RolePrivilegeItemStatus string
 // RoleStatus Whether the organization's license to use the role is valid or has expired.
 type RoleStatus string
 
+// SamlAttributeMappings defines model for samlAttributeMappings.
+type SamlAttributeMappings struct {
+	// Email Assertion attribute holding the user's email address.
+	Email *string `json:"email,omitempty"`
+
+	// Groups Assertion attribute holding the groups the user belongs to.
+	Groups *string `json:"groups,omitempty"`
+
+	// UserName Assertion attribute holding the user's name.
+	UserName *string `json:"userName,omitempty"`
+}
+
+// SamlConfig defines model for samlConfig.
+type SamlConfig struct {
+	AttributeMappings *SamlAttributeMappings `json:"attributeMappings,omitempty"`
+
+	// IdpCertificate Certificate the identity provider signs assertions with, either PEM or base64 encoded DER.
+	IdpCertificate string `json:"idpCertificate"`
+
+	// IdpIssuer Entity ID of the identity provider.
+	IdpIssuer string `json:"idpIssuer"`
+
+	// IdpSsoUrl URL of the identity provider's single sign-on service.
+	IdpSsoUrl    string             `json:"idpSsoUrl"`
+	RoleMappings *[]SamlRoleMapping `json:"roleMappings,omitempty"`
+}
+
+// SamlRoleMapping defines model for samlRoleMapping.
+type SamlRoleMapping struct {
+	// IdpGroup Name of the group in the identity provider.
+	IdpGroup string `json:"idpGroup"`
+
+	// Roles Names of the IDMC roles granted to members of the group.
+	Roles []string `json:"roles"`
+}
+
+// TrustedIpRange defines model for trustedIpRange.
+type TrustedIpRange struct {
+	// EndIp Last IP address in the range.
+	EndIp string `json:"endIp"`
+
+	// StartIp First IP address in the range.
+	StartIp string `json:"startIp"`
+}
+
+// TrustedIpRanges defines model for trustedIpRanges.
+type TrustedIpRanges struct {
+	// Enabled Whether access is restricted to the trusted IP address ranges.
+	Enabled  bool             `json:"enabled"`
+	IpRanges []TrustedIpRange `json:"ipRanges"`
+}
+
+// UpdateRoleDetailsRequestBody defines model for updateRoleDetailsRequestBody.
+type UpdateRoleDetailsRequestBody struct {
+	// Description New description of the role.
+	Description *string `json:"description,omitempty"`
+
+	// Name New name of the role.
+	Name *string `json:"name,omitempty"`
+}
+
 // UpdateRoleRequestBody defines model for updateRoleRequestBody.
 type UpdateRoleRequestBody struct {
 	// Privileges IDs of the privileges to assign to the role.
@@ -315,9 +520,18 @@ type WithPrivilegeRefs struct {
 // HeaderSession defines model for headerSession.
 type HeaderSession = string
 
+// PathPublishJob defines model for pathPublishJob.
+type PathPublishJob = string
+
 // PathRole defines model for pathRole.
 type PathRole = string
 
+// QueryLimit defines model for queryLimit.
+type QueryLimit = int32
+
+// QuerySkip defines model for querySkip.
+type QuerySkip = int32
+
 // N204 When the REST API encounters an error, it returns a REST API error object.
 type N204 = ApiErrorResponseBody
 
@@ -347,10 +561,51 @@ type RolePrivileges = UpdateRoleRequestBody
 
 // <editor-fold desc="param-types" defaultstate="collapsed"> ///////////////////
 
+// GetTrustedIpRangesParams defines parameters for GetTrustedIpRanges.
+type GetTrustedIpRangesParams struct {
+	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
+}
+
+// UpdateTrustedIpRangesParams defines parameters for UpdateTrustedIpRanges.
+type UpdateTrustedIpRangesParams struct {
+	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
+}
+
+// ControlAgentServiceParams defines parameters for ControlAgentService.
+type ControlAgentServiceParams struct {
+	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
+}
+
+// LogoutParams defines parameters for Logout.
+type LogoutParams struct {
+	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
+}
+
+// LookupObjectsParams defines parameters for LookupObjects.
+type LookupObjectsParams struct {
+	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
+}
+
 // ListPrivilegesParams defines parameters for ListPrivileges.
 type ListPrivilegesParams struct {
 	// Q The query string used to filter results.
 	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Limit The maximum number of items to return. The api caps this at 200.
+	Limit *QueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Skip The number of items to skip over, for paging through large result sets.
+	Skip *QuerySkip `form:"skip,omitempty" json:"skip,omitempty"`
+}
+
+// PublishAssetsParams defines parameters for PublishAssets.
+type PublishAssetsParams struct {
+	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
+}
+
+// GetPublishJobParams defines parameters for GetPublishJob.
+type GetPublishJobParams struct {
+	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
 }
 
 // GetRolesParams defines parameters for GetRoles.
@@ -361,8 +616,14 @@ type GetRolesParams struct {
 	Q *string `form:"q,omitempty" json:"q,omitempty"`
 
 	// Expand Returns the privileges associated with the role specified in the query filter.
-	Expand        *GetRolesParamsExpand `form:"expand,omitempty" json:"expand,omitempty"`
-	INFASESSIONID HeaderSession         `json:"INFA-SESSION-ID"`
+	Expand *GetRolesParamsExpand `form:"expand,omitempty" json:"expand,omitempty"`
+
+	// Limit The maximum number of items to return. The api caps this at 200.
+	Limit *QueryLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Skip The number of items to skip over, for paging through large result sets.
+	Skip          *QuerySkip    `form:"skip,omitempty" json:"skip,omitempty"`
+	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
 }
 
 // GetRolesParamsExpand defines parameters for GetRoles.
@@ -373,6 +634,11 @@ type DeleteRoleParams struct {
 	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
 }
 
+// UpdateRoleParams defines parameters for UpdateRole.
+type UpdateRoleParams struct {
+	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
+}
+
 // AddRolePrivilegesParams defines parameters for AddRolePrivileges.
 type AddRolePrivilegesParams struct {
 	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
@@ -383,22 +649,73 @@ type RemoveRolePrivilegesParams struct {
 	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
 }
 
+// DeleteSamlConfigParams defines parameters for DeleteSamlConfig.
+type DeleteSamlConfigParams struct {
+	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
+}
+
+// GetSamlConfigParams defines parameters for GetSamlConfig.
+type GetSamlConfigParams struct {
+	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
+}
+
+// UpdateSamlConfigParams defines parameters for UpdateSamlConfig.
+type UpdateSamlConfigParams struct {
+	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
+}
+
+// GetSamlSpMetadataParams defines parameters for GetSamlSpMetadata.
+type GetSamlSpMetadataParams struct {
+	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
+}
+
+// UnpublishAssetsParams defines parameters for UnpublishAssets.
+type UnpublishAssetsParams struct {
+	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
+}
+
+// GetUnpublishJobParams defines parameters for GetUnpublishJob.
+type GetUnpublishJobParams struct {
+	INFASESSIONID HeaderSession `json:"INFA-SESSION-ID"`
+}
+
 // </editor-fold> //////////////////////////////////////////////////////////////
 
 // <editor-fold desc="request-bodies" defaultstate="collapsed"> ////////////////
 
+// UpdateTrustedIpRangesJSONRequestBody defines body for UpdateTrustedIpRanges for application/json ContentType.
+type UpdateTrustedIpRangesJSONRequestBody = TrustedIpRanges
+
+// ControlAgentServiceJSONRequestBody defines body for ControlAgentService for application/json ContentType.
+type ControlAgentServiceJSONRequestBody = AgentServiceRequestBody
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody = LoginRequestBody
 
+// LookupObjectsJSONRequestBody defines body for LookupObjects for application/json ContentType.
+type LookupObjectsJSONRequestBody = LookupRequestBody
+
+// PublishAssetsJSONRequestBody defines body for PublishAssets for application/json ContentType.
+type PublishAssetsJSONRequestBody = PublishRequestBody
+
 // CreateRoleJSONRequestBody defines body for CreateRole for application/json ContentType.
 type CreateRoleJSONRequestBody = CreateRoleRequestBody
 
+// UpdateRoleJSONRequestBody defines body for UpdateRole for application/json ContentType.
+type UpdateRoleJSONRequestBody = UpdateRoleDetailsRequestBody
+
 // AddRolePrivilegesJSONRequestBody defines body for AddRolePrivileges for application/json ContentType.
 type AddRolePrivilegesJSONRequestBody = UpdateRoleRequestBody
 
 // RemoveRolePrivilegesJSONRequestBody defines body for RemoveRolePrivileges for application/json ContentType.
 type RemoveRolePrivilegesJSONRequestBody = UpdateRoleRequestBody
 
+// UpdateSamlConfigJSONRequestBody defines body for UpdateSamlConfig for application/json ContentType.
+type UpdateSamlConfigJSONRequestBody = SamlConfig
+
+// UnpublishAssetsJSONRequestBody defines body for UnpublishAssets for application/json ContentType.
+type UnpublishAssetsJSONRequestBody = PublishRequestBody
+
 // </editor-fold> //////////////////////////////////////////////////////////////
 
 // <editor-fold desc="client" defaultstate="collapsed"> ////////////////////////
@@ -422,14 +739,43 @@ func (c *Client) Config() *common.ClientConfig {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetTrustedIpRanges request
+	GetTrustedIpRanges(ctx context.Context, params *GetTrustedIpRangesParams, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// UpdateTrustedIpRangesWithBody request with any body
+	UpdateTrustedIpRangesWithBody(ctx context.Context, params *UpdateTrustedIpRangesParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	UpdateTrustedIpRanges(ctx context.Context, params *UpdateTrustedIpRangesParams, body UpdateTrustedIpRangesJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// ControlAgentServiceWithBody request with any body
+	ControlAgentServiceWithBody(ctx context.Context, params *ControlAgentServiceParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	ControlAgentService(ctx context.Context, params *ControlAgentServiceParams, body ControlAgentServiceJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
 	// LoginWithBody request with any body
 	LoginWithBody(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
 
 	Login(ctx context.Context, body LoginJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
 
+	// Logout request
+	Logout(ctx context.Context, params *LogoutParams, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// LookupObjectsWithBody request with any body
+	LookupObjectsWithBody(ctx context.Context, params *LookupObjectsParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	LookupObjects(ctx context.Context, params *LookupObjectsParams, body LookupObjectsJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
 	// ListPrivileges request
 	ListPrivileges(ctx context.Context, params *ListPrivilegesParams, editors ...common.ClientConfigEditor) (*http.Response, error)
 
+	// PublishAssetsWithBody request with any body
+	PublishAssetsWithBody(ctx context.Context, params *PublishAssetsParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	PublishAssets(ctx context.Context, params *PublishAssetsParams, body PublishAssetsJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// GetPublishJob request
+	GetPublishJob(ctx context.Context, jobId PathPublishJob, params *GetPublishJobParams, editors ...common.ClientConfigEditor) (*http.Response, error)
+
 	// GetRoles request
 	GetRoles(ctx context.Context, params *GetRolesParams, editors ...common.ClientConfigEditor) (*http.Response, error)
 
@@ -441,6 +787,11 @@ type ClientInterface interface {
 	// DeleteRole request
 	DeleteRole(ctx context.Context, roleRef PathRole, params *DeleteRoleParams, editors ...common.ClientConfigEditor) (*http.Response, error)
 
+	// UpdateRoleWithBody request with any body
+	UpdateRoleWithBody(ctx context.Context, roleRef PathRole, params *UpdateRoleParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	UpdateRole(ctx context.Context, roleRef PathRole, params *UpdateRoleParams, body UpdateRoleJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
 	// AddRolePrivilegesWithBody request with any body
 	AddRolePrivilegesWithBody(ctx context.Context, roleRef PathRole, params *AddRolePrivilegesParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
 
@@ -450,6 +801,58 @@ type ClientInterface interface {
 	RemoveRolePrivilegesWithBody(ctx context.Context, roleRef PathRole, params *RemoveRolePrivilegesParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
 
 	RemoveRolePrivileges(ctx context.Context, roleRef PathRole, params *RemoveRolePrivilegesParams, body RemoveRolePrivilegesJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// DeleteSamlConfig request
+	DeleteSamlConfig(ctx context.Context, params *DeleteSamlConfigParams, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// GetSamlConfig request
+	GetSamlConfig(ctx context.Context, params *GetSamlConfigParams, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// UpdateSamlConfigWithBody request with any body
+	UpdateSamlConfigWithBody(ctx context.Context, params *UpdateSamlConfigParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	UpdateSamlConfig(ctx context.Context, params *UpdateSamlConfigParams, body UpdateSamlConfigJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// GetSamlSpMetadata request
+	GetSamlSpMetadata(ctx context.Context, params *GetSamlSpMetadataParams, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// UnpublishAssetsWithBody request with any body
+	UnpublishAssetsWithBody(ctx context.Context, params *UnpublishAssetsParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	UnpublishAssets(ctx context.Context, params *UnpublishAssetsParams, body UnpublishAssetsJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error)
+
+	// GetUnpublishJob request
+	GetUnpublishJob(ctx context.Context, jobId PathPublishJob, params *GetUnpublishJobParams, editors ...common.ClientConfigEditor) (*http.Response, error)
+}
+
+func (c *Client) GetTrustedIpRanges(ctx context.Context, params *GetTrustedIpRangesParams, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewGetTrustedIpRangesRequest(c.Server, params)
+	})
+}
+
+func (c *Client) UpdateTrustedIpRangesWithBody(ctx context.Context, params *UpdateTrustedIpRangesParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateTrustedIpRangesRequestWithBody(c.Server, params, contentType, body)
+	})
+}
+
+func (c *Client) UpdateTrustedIpRanges(ctx context.Context, params *UpdateTrustedIpRangesParams, body UpdateTrustedIpRangesJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateTrustedIpRangesRequest(c.Server, params, body)
+	})
+}
+
+func (c *Client) ControlAgentServiceWithBody(ctx context.Context, params *ControlAgentServiceParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewControlAgentServiceRequestWithBody(c.Server, params, contentType, body)
+	})
+}
+
+func (c *Client) ControlAgentService(ctx context.Context, params *ControlAgentServiceParams, body ControlAgentServiceJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewControlAgentServiceRequest(c.Server, params, body)
+	})
 }
 
 func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
@@ -464,12 +867,48 @@ func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, editors .
 	})
 }
 
+func (c *Client) Logout(ctx context.Context, params *LogoutParams, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewLogoutRequest(c.Server, params)
+	})
+}
+
+func (c *Client) LookupObjectsWithBody(ctx context.Context, params *LookupObjectsParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewLookupObjectsRequestWithBody(c.Server, params, contentType, body)
+	})
+}
+
+func (c *Client) LookupObjects(ctx context.Context, params *LookupObjectsParams, body LookupObjectsJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewLookupObjectsRequest(c.Server, params, body)
+	})
+}
+
 func (c *Client) ListPrivileges(ctx context.Context, params *ListPrivilegesParams, editors ...common.ClientConfigEditor) (*http.Response, error) {
 	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
 		return NewListPrivilegesRequest(c.Server, params)
 	})
 }
 
+func (c *Client) PublishAssetsWithBody(ctx context.Context, params *PublishAssetsParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewPublishAssetsRequestWithBody(c.Server, params, contentType, body)
+	})
+}
+
+func (c *Client) PublishAssets(ctx context.Context, params *PublishAssetsParams, body PublishAssetsJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewPublishAssetsRequest(c.Server, params, body)
+	})
+}
+
+func (c *Client) GetPublishJob(ctx context.Context, jobId PathPublishJob, params *GetPublishJobParams, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewGetPublishJobRequest(c.Server, jobId, params)
+	})
+}
+
 func (c *Client) GetRoles(ctx context.Context, params *GetRolesParams, editors ...common.ClientConfigEditor) (*http.Response, error) {
 	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
 		return NewGetRolesRequest(c.Server, params)
@@ -494,6 +933,18 @@ func (c *Client) DeleteRole(ctx context.Context, roleRef PathRole, params *Delet
 	})
 }
 
+func (c *Client) UpdateRoleWithBody(ctx context.Context, roleRef PathRole, params *UpdateRoleParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateRoleRequestWithBody(c.Server, roleRef, params, contentType, body)
+	})
+}
+
+func (c *Client) UpdateRole(ctx context.Context, roleRef PathRole, params *UpdateRoleParams, body UpdateRoleJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateRoleRequest(c.Server, roleRef, params, body)
+	})
+}
+
 func (c *Client) AddRolePrivilegesWithBody(ctx context.Context, roleRef PathRole, params *AddRolePrivilegesParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
 	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
 		return NewAddRolePrivilegesRequestWithBody(c.Server, roleRef, params, contentType, body)
@@ -518,19 +969,56 @@ func (c *Client) RemoveRolePrivileges(ctx context.Context, roleRef PathRole, par
 	})
 }
 
-// NewLoginRequest calls the generic Login builder with application/json body
-func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewLoginRequestWithBody(server, "application/json", bodyReader)
+func (c *Client) DeleteSamlConfig(ctx context.Context, params *DeleteSamlConfigParams, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewDeleteSamlConfigRequest(c.Server, params)
+	})
 }
 
-// NewLoginRequestWithBody generates requests for Login with any type of body
-func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+func (c *Client) GetSamlConfig(ctx context.Context, params *GetSamlConfigParams, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewGetSamlConfigRequest(c.Server, params)
+	})
+}
+
+func (c *Client) UpdateSamlConfigWithBody(ctx context.Context, params *UpdateSamlConfigParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateSamlConfigRequestWithBody(c.Server, params, contentType, body)
+	})
+}
+
+func (c *Client) UpdateSamlConfig(ctx context.Context, params *UpdateSamlConfigParams, body UpdateSamlConfigJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUpdateSamlConfigRequest(c.Server, params, body)
+	})
+}
+
+func (c *Client) GetSamlSpMetadata(ctx context.Context, params *GetSamlSpMetadataParams, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewGetSamlSpMetadataRequest(c.Server, params)
+	})
+}
+
+func (c *Client) UnpublishAssetsWithBody(ctx context.Context, params *UnpublishAssetsParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUnpublishAssetsRequestWithBody(c.Server, params, contentType, body)
+	})
+}
+
+func (c *Client) UnpublishAssets(ctx context.Context, params *UnpublishAssetsParams, body UnpublishAssetsJSONRequestBody, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewUnpublishAssetsRequest(c.Server, params, body)
+	})
+}
+
+func (c *Client) GetUnpublishJob(ctx context.Context, jobId PathPublishJob, params *GetUnpublishJobParams, editors ...common.ClientConfigEditor) (*http.Response, error) {
+	return c.HandleRequest(ctx, editors, func() (*http.Request, error) {
+		return NewGetUnpublishJobRequest(c.Server, jobId, params)
+	})
+}
+
+// NewGetTrustedIpRangesRequest generates requests for GetTrustedIpRanges
+func NewGetTrustedIpRangesRequest(server string, params *GetTrustedIpRangesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -538,7 +1026,7 @@ func NewLoginRequestWithBody(server string, contentType string, body io.Reader)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/public/core/v3/login")
+	operationPath := fmt.Sprintf("/public/core/v3/TrustedIP")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -548,18 +1036,40 @@ func NewLoginRequestWithBody(server string, contentType string, body io.Reader)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "INFA-SESSION-ID", runtime.ParamLocationHeader, params.INFASESSIONID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("INFA-SESSION-ID", headerParam0)
+
+	}
 
 	return req, nil
 }
 
-// NewListPrivilegesRequest generates requests for ListPrivileges
-func NewListPrivilegesRequest(server string, params *ListPrivilegesParams) (*http.Request, error) {
+// NewUpdateTrustedIpRangesRequest calls the generic UpdateTrustedIpRanges builder with application/json body
+func NewUpdateTrustedIpRangesRequest(server string, params *UpdateTrustedIpRangesParams, body UpdateTrustedIpRangesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTrustedIpRangesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewUpdateTrustedIpRangesRequestWithBody generates requests for UpdateTrustedIpRanges with any type of body
+func NewUpdateTrustedIpRangesRequestWithBody(server string, params *UpdateTrustedIpRangesParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -567,7 +1077,7 @@ func NewListPrivilegesRequest(server string, params *ListPrivilegesParams) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/public/core/v3/privileges")
+	operationPath := fmt.Sprintf("/public/core/v3/TrustedIP")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -577,38 +1087,42 @@ func NewListPrivilegesRequest(server string, params *ListPrivilegesParams) (*htt
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Q != nil {
+	req.Header.Add("Content-Type", contentType)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	if params != nil {
+
+		var headerParam0 string
 
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "INFA-SESSION-ID", runtime.ParamLocationHeader, params.INFASESSIONID)
+		if err != nil {
+			return nil, err
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
+		req.Header.Set("INFA-SESSION-ID", headerParam0)
+
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	return req, nil
+}
+
+// NewControlAgentServiceRequest calls the generic ControlAgentService builder with application/json body
+func NewControlAgentServiceRequest(server string, params *ControlAgentServiceParams, body ControlAgentServiceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewControlAgentServiceRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewGetRolesRequest generates requests for GetRoles
-func NewGetRolesRequest(server string, params *GetRolesParams) (*http.Request, error) {
+// NewControlAgentServiceRequestWithBody generates requests for ControlAgentService with any type of body
+func NewControlAgentServiceRequestWithBody(server string, params *ControlAgentServiceParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -616,7 +1130,7 @@ func NewGetRolesRequest(server string, params *GetRolesParams) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/public/core/v3/roles")
+	operationPath := fmt.Sprintf("/public/core/v3/agent/service")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -626,49 +1140,13 @@ func NewGetRolesRequest(server string, params *GetRolesParams) (*http.Request, e
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Q != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Expand != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	if params != nil {
 
 		var headerParam0 string
@@ -685,19 +1163,19 @@ func NewGetRolesRequest(server string, params *GetRolesParams) (*http.Request, e
 	return req, nil
 }
 
-// NewCreateRoleRequest calls the generic CreateRole builder with application/json body
-func NewCreateRoleRequest(server string, body CreateRoleJSONRequestBody) (*http.Request, error) {
+// NewLoginRequest calls the generic Login builder with application/json body
+func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateRoleRequestWithBody(server, "application/json", bodyReader)
+	return NewLoginRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateRoleRequestWithBody generates requests for CreateRole with any type of body
-func NewCreateRoleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewLoginRequestWithBody generates requests for Login with any type of body
+func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -705,7 +1183,7 @@ func NewCreateRoleRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/public/core/v3/roles")
+	operationPath := fmt.Sprintf("/public/core/v3/login")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -725,23 +1203,16 @@ func NewCreateRoleRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
-// NewDeleteRoleRequest generates requests for DeleteRole
-func NewDeleteRoleRequest(server string, roleRef PathRole, params *DeleteRoleParams) (*http.Request, error) {
+// NewLogoutRequest generates requests for Logout
+func NewLogoutRequest(server string, params *LogoutParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "role_ref", runtime.ParamLocationPath, roleRef)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/public/core/v3/roles/%s", pathParam0)
+	operationPath := fmt.Sprintf("/public/core/v3/logout")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -751,7 +1222,7 @@ func NewDeleteRoleRequest(server string, roleRef PathRole, params *DeleteRolePar
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -772,34 +1243,27 @@ func NewDeleteRoleRequest(server string, roleRef PathRole, params *DeleteRolePar
 	return req, nil
 }
 
-// NewAddRolePrivilegesRequest calls the generic AddRolePrivileges builder with application/json body
-func NewAddRolePrivilegesRequest(server string, roleRef PathRole, params *AddRolePrivilegesParams, body AddRolePrivilegesJSONRequestBody) (*http.Request, error) {
+// NewLookupObjectsRequest calls the generic LookupObjects builder with application/json body
+func NewLookupObjectsRequest(server string, params *LookupObjectsParams, body LookupObjectsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewAddRolePrivilegesRequestWithBody(server, roleRef, params, "application/json", bodyReader)
+	return NewLookupObjectsRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewAddRolePrivilegesRequestWithBody generates requests for AddRolePrivileges with any type of body
-func NewAddRolePrivilegesRequestWithBody(server string, roleRef PathRole, params *AddRolePrivilegesParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewLookupObjectsRequestWithBody generates requests for LookupObjects with any type of body
+func NewLookupObjectsRequestWithBody(server string, params *LookupObjectsParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "role_ref", runtime.ParamLocationPath, roleRef)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/public/core/v3/roles/%s/addPrivileges", pathParam0)
+	operationPath := fmt.Sprintf("/public/core/v3/lookup")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -809,7 +1273,7 @@ func NewAddRolePrivilegesRequestWithBody(server string, roleRef PathRole, params
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -832,34 +1296,108 @@ func NewAddRolePrivilegesRequestWithBody(server string, roleRef PathRole, params
 	return req, nil
 }
 
-// NewRemoveRolePrivilegesRequest calls the generic RemoveRolePrivileges builder with application/json body
-func NewRemoveRolePrivilegesRequest(server string, roleRef PathRole, params *RemoveRolePrivilegesParams, body RemoveRolePrivilegesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewListPrivilegesRequest generates requests for ListPrivileges
+func NewListPrivilegesRequest(server string, params *ListPrivilegesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewRemoveRolePrivilegesRequestWithBody(server, roleRef, params, "application/json", bodyReader)
-}
 
-// NewRemoveRolePrivilegesRequestWithBody generates requests for RemoveRolePrivileges with any type of body
-func NewRemoveRolePrivilegesRequestWithBody(server string, roleRef PathRole, params *RemoveRolePrivilegesParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	operationPath := fmt.Sprintf("/public/core/v3/privileges")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	var pathParam0 string
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "role_ref", runtime.ParamLocationPath, roleRef)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Skip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPublishAssetsRequest calls the generic PublishAssets builder with application/json body
+func NewPublishAssetsRequest(server string, params *PublishAssetsParams, body PublishAssetsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPublishAssetsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPublishAssetsRequestWithBody generates requests for PublishAssets with any type of body
+func NewPublishAssetsRequestWithBody(server string, params *PublishAssetsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/public/core/v3/roles/%s/removePrivileges", pathParam0)
+	operationPath := fmt.Sprintf("/public/core/v3/publish")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -869,7 +1407,7 @@ func NewRemoveRolePrivilegesRequestWithBody(server string, roleRef PathRole, par
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -892,521 +1430,2885 @@ func NewRemoveRolePrivilegesRequestWithBody(server string, roleRef PathRole, par
 	return req, nil
 }
 
-// </editor-fold> //////////////////////////////////////////////////////////////
-// <editor-fold desc="client-with-responses" defaultstate="collapsed"> /////////
+// NewGetPublishJobRequest generates requests for GetPublishJob
+func NewGetPublishJobRequest(server string, jobId PathPublishJob, params *GetPublishJobParams) (*http.Request, error) {
+	var err error
 
-// ClientWithResponses builds on Client to offer response payloads
-type ClientWithResponses struct {
-	*Client
-}
+	var pathParam0 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...common.ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// LoginWithBodyWithResponse request with any body
-	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*LoginResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, editors ...common.ClientConfigEditor) (*LoginResponse, error)
-
-	// ListPrivilegesWithResponse request
-	ListPrivilegesWithResponse(ctx context.Context, params *ListPrivilegesParams, editors ...common.ClientConfigEditor) (*ListPrivilegesResponse, error)
+	operationPath := fmt.Sprintf("/public/core/v3/publish/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetRolesWithResponse request
-	GetRolesWithResponse(ctx context.Context, params *GetRolesParams, editors ...common.ClientConfigEditor) (*GetRolesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateRoleWithBodyWithResponse request with any body
-	CreateRoleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*CreateRoleResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateRoleWithResponse(ctx context.Context, body CreateRoleJSONRequestBody, editors ...common.ClientConfigEditor) (*CreateRoleResponse, error)
+	if params != nil {
 
-	// DeleteRoleWithResponse request
-	DeleteRoleWithResponse(ctx context.Context, roleRef PathRole, params *DeleteRoleParams, editors ...common.ClientConfigEditor) (*DeleteRoleResponse, error)
+		var headerParam0 string
 
-	// AddRolePrivilegesWithBodyWithResponse request with any body
-	AddRolePrivilegesWithBodyWithResponse(ctx context.Context, roleRef PathRole, params *AddRolePrivilegesParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*AddRolePrivilegesResponse, error)
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "INFA-SESSION-ID", runtime.ParamLocationHeader, params.INFASESSIONID)
+		if err != nil {
+			return nil, err
+		}
 
-	AddRolePrivilegesWithResponse(ctx context.Context, roleRef PathRole, params *AddRolePrivilegesParams, body AddRolePrivilegesJSONRequestBody, editors ...common.ClientConfigEditor) (*AddRolePrivilegesResponse, error)
+		req.Header.Set("INFA-SESSION-ID", headerParam0)
 
-	// RemoveRolePrivilegesWithBodyWithResponse request with any body
-	RemoveRolePrivilegesWithBodyWithResponse(ctx context.Context, roleRef PathRole, params *RemoveRolePrivilegesParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*RemoveRolePrivilegesResponse, error)
+	}
 
-	RemoveRolePrivilegesWithResponse(ctx context.Context, roleRef PathRole, params *RemoveRolePrivilegesParams, body RemoveRolePrivilegesJSONRequestBody, editors ...common.ClientConfigEditor) (*RemoveRolePrivilegesResponse, error)
+	return req, nil
 }
 
-type LoginResponse struct {
-	common.ClientResponse
-	JSON200 *LoginResponseBody
-	JSON400 *N400
-	JSON401 *N401
-	JSON403 *N403
-	JSON404 *N404
-	JSON500 *N500
-	JSON502 *N502
-	JSON503 *N503
-}
+// NewGetRolesRequest generates requests for GetRoles
+func NewGetRolesRequest(server string, params *GetRolesParams) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r LoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/public/core/v3/roles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-// HttpResponse returns HTTPResponse
-func (r LoginResponse) HttpResponse() *http.Response {
-	return r.HTTPResponse
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// BodyData returns HTTPResponse.Body
-func (r LoginResponse) BodyData() []byte {
-	return r.Body
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-type ListPrivilegesResponse struct {
-	common.ClientResponse
-	JSON200 *[]RolePrivilegeItem
-	JSON400 *N400
-	JSON401 *N401
-	JSON403 *N403
-	JSON404 *N404
-	JSON500 *N500
-	JSON502 *N502
-	JSON503 *N503
-}
+		if params.Q != nil {
 
-// Status returns HTTPResponse.Status
-func (r ListPrivilegesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListPrivilegesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+		}
 
-// HttpResponse returns HTTPResponse
-func (r ListPrivilegesResponse) HttpResponse() *http.Response {
-	return r.HTTPResponse
-}
+		if params.Expand != nil {
 
-// BodyData returns HTTPResponse.Body
-func (r ListPrivilegesResponse) BodyData() []byte {
-	return r.Body
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type GetRolesResponse struct {
-	common.ClientResponse
-	JSON200 *GetRolesResponseBody
-	JSON400 *N400
-	JSON401 *N401
-	JSON403 *N403
-	JSON404 *N404
-	JSON500 *N500
-	JSON502 *N502
-	JSON503 *N503
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r GetRolesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Limit != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetRolesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// HttpResponse returns HTTPResponse
-func (r GetRolesResponse) HttpResponse() *http.Response {
-	return r.HTTPResponse
-}
+		}
 
-// BodyData returns HTTPResponse.Body
-func (r GetRolesResponse) BodyData() []byte {
-	return r.Body
-}
+		if params.Skip != nil {
 
-type CreateRoleResponse struct {
-	common.ClientResponse
-	JSON201 *CreateRoleResponseBody
-	JSON400 *N400
-	JSON401 *N401
-	JSON403 *N403
-	JSON404 *N404
-	JSON500 *N500
-	JSON502 *N502
-	JSON503 *N503
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// Status returns HTTPResponse.Status
-func (r CreateRoleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateRoleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// HttpResponse returns HTTPResponse
-func (r CreateRoleResponse) HttpResponse() *http.Response {
-	return r.HTTPResponse
-}
+	if params != nil {
 
-// BodyData returns HTTPResponse.Body
-func (r CreateRoleResponse) BodyData() []byte {
-	return r.Body
-}
+		var headerParam0 string
 
-type DeleteRoleResponse struct {
-	common.ClientResponse
-	JSON204 *N204
-	JSON400 *N400
-	JSON401 *N401
-	JSON403 *N403
-	JSON404 *N404
-	JSON500 *N500
-	JSON502 *N502
-	JSON503 *N503
-}
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "INFA-SESSION-ID", runtime.ParamLocationHeader, params.INFASESSIONID)
+		if err != nil {
+			return nil, err
+		}
 
-// Status returns HTTPResponse.Status
-func (r DeleteRoleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		req.Header.Set("INFA-SESSION-ID", headerParam0)
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteRoleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-// HttpResponse returns HTTPResponse
-func (r DeleteRoleResponse) HttpResponse() *http.Response {
-	return r.HTTPResponse
+	return req, nil
 }
 
-// BodyData returns HTTPResponse.Body
-func (r DeleteRoleResponse) BodyData() []byte {
-	return r.Body
+// NewCreateRoleRequest calls the generic CreateRole builder with application/json body
+func NewCreateRoleRequest(server string, body CreateRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRoleRequestWithBody(server, "application/json", bodyReader)
 }
 
-type AddRolePrivilegesResponse struct {
-	common.ClientResponse
-	JSON400 *N400
-	JSON401 *N401
-	JSON403 *N403
-	JSON404 *N404
-	JSON500 *N500
-	JSON502 *N502
-	JSON503 *N503
-}
+// NewCreateRoleRequestWithBody generates requests for CreateRole with any type of body
+func NewCreateRoleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r AddRolePrivilegesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddRolePrivilegesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/public/core/v3/roles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-// HttpResponse returns HTTPResponse
-func (r AddRolePrivilegesResponse) HttpResponse() *http.Response {
-	return r.HTTPResponse
-}
-
-// BodyData returns HTTPResponse.Body
-func (r AddRolePrivilegesResponse) BodyData() []byte {
-	return r.Body
-}
-
-type RemoveRolePrivilegesResponse struct {
-	common.ClientResponse
-	JSON400 *N400
-	JSON401 *N401
-	JSON403 *N403
-	JSON404 *N404
-	JSON500 *N500
-	JSON502 *N502
-	JSON503 *N503
-}
 
-// Status returns HTTPResponse.Status
-func (r RemoveRolePrivilegesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RemoveRolePrivilegesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// HttpResponse returns HTTPResponse
-func (r RemoveRolePrivilegesResponse) HttpResponse() *http.Response {
-	return r.HTTPResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// BodyData returns HTTPResponse.Body
-func (r RemoveRolePrivilegesResponse) BodyData() []byte {
-	return r.Body
+	return req, nil
 }
 
-// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
-func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*LoginResponse, error) {
-	rsp, err := c.LoginWithBody(ctx, contentType, body, editors...)
+// NewDeleteRoleRequest generates requests for DeleteRole
+func NewDeleteRoleRequest(server string, roleRef PathRole, params *DeleteRoleParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "role_ref", runtime.ParamLocationPath, roleRef)
 	if err != nil {
 		return nil, err
 	}
-	apiRes, err := ParseLoginResponse(rsp)
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
-		return nil, err
+
+	operationPath := fmt.Sprintf("/public/core/v3/roles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return apiRes, nil
-}
 
-func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, editors ...common.ClientConfigEditor) (*LoginResponse, error) {
-	rsp, err := c.Login(ctx, body, editors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	apiRes, err := ParseLoginResponse(rsp)
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
-		return nil, err
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "INFA-SESSION-ID", runtime.ParamLocationHeader, params.INFASESSIONID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("INFA-SESSION-ID", headerParam0)
+
 	}
-	return apiRes, nil
+
+	return req, nil
 }
 
-// ListPrivilegesWithResponse request returning *ListPrivilegesResponse
-func (c *ClientWithResponses) ListPrivilegesWithResponse(ctx context.Context, params *ListPrivilegesParams, editors ...common.ClientConfigEditor) (*ListPrivilegesResponse, error) {
-	rsp, err := c.ListPrivileges(ctx, params, editors...)
-	if err != nil {
-		return nil, err
-	}
-	apiRes, err := ParseListPrivilegesResponse(rsp)
+// NewUpdateRoleRequest calls the generic UpdateRole builder with application/json body
+func NewUpdateRoleRequest(server string, roleRef PathRole, params *UpdateRoleParams, body UpdateRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
-		return nil, err
-	}
-	return apiRes, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRoleRequestWithBody(server, roleRef, params, "application/json", bodyReader)
 }
 
-// GetRolesWithResponse request returning *GetRolesResponse
-func (c *ClientWithResponses) GetRolesWithResponse(ctx context.Context, params *GetRolesParams, editors ...common.ClientConfigEditor) (*GetRolesResponse, error) {
-	rsp, err := c.GetRoles(ctx, params, editors...)
+// NewUpdateRoleRequestWithBody generates requests for UpdateRole with any type of body
+func NewUpdateRoleRequestWithBody(server string, roleRef PathRole, params *UpdateRoleParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "role_ref", runtime.ParamLocationPath, roleRef)
 	if err != nil {
 		return nil, err
 	}
-	apiRes, err := ParseGetRolesResponse(rsp)
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
-		return nil, err
+
+	operationPath := fmt.Sprintf("/public/core/v3/roles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return apiRes, nil
-}
 
-// CreateRoleWithBodyWithResponse request with arbitrary body returning *CreateRoleResponse
-func (c *ClientWithResponses) CreateRoleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*CreateRoleResponse, error) {
-	rsp, err := c.CreateRoleWithBody(ctx, contentType, body, editors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	apiRes, err := ParseCreateRoleResponse(rsp)
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
-		return nil, err
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "INFA-SESSION-ID", runtime.ParamLocationHeader, params.INFASESSIONID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("INFA-SESSION-ID", headerParam0)
+
 	}
-	return apiRes, nil
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) CreateRoleWithResponse(ctx context.Context, body CreateRoleJSONRequestBody, editors ...common.ClientConfigEditor) (*CreateRoleResponse, error) {
-	rsp, err := c.CreateRole(ctx, body, editors...)
-	if err != nil {
-		return nil, err
-	}
-	apiRes, err := ParseCreateRoleResponse(rsp)
+// NewAddRolePrivilegesRequest calls the generic AddRolePrivileges builder with application/json body
+func NewAddRolePrivilegesRequest(server string, roleRef PathRole, params *AddRolePrivilegesParams, body AddRolePrivilegesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
-		return nil, err
-	}
-	return apiRes, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewAddRolePrivilegesRequestWithBody(server, roleRef, params, "application/json", bodyReader)
 }
 
-// DeleteRoleWithResponse request returning *DeleteRoleResponse
-func (c *ClientWithResponses) DeleteRoleWithResponse(ctx context.Context, roleRef PathRole, params *DeleteRoleParams, editors ...common.ClientConfigEditor) (*DeleteRoleResponse, error) {
-	rsp, err := c.DeleteRole(ctx, roleRef, params, editors...)
+// NewAddRolePrivilegesRequestWithBody generates requests for AddRolePrivileges with any type of body
+func NewAddRolePrivilegesRequestWithBody(server string, roleRef PathRole, params *AddRolePrivilegesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "role_ref", runtime.ParamLocationPath, roleRef)
 	if err != nil {
 		return nil, err
 	}
-	apiRes, err := ParseDeleteRoleResponse(rsp)
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
-		return nil, err
+
+	operationPath := fmt.Sprintf("/public/core/v3/roles/%s/addPrivileges", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return apiRes, nil
-}
 
-// AddRolePrivilegesWithBodyWithResponse request with arbitrary body returning *AddRolePrivilegesResponse
-func (c *ClientWithResponses) AddRolePrivilegesWithBodyWithResponse(ctx context.Context, roleRef PathRole, params *AddRolePrivilegesParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*AddRolePrivilegesResponse, error) {
-	rsp, err := c.AddRolePrivilegesWithBody(ctx, roleRef, params, contentType, body, editors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	apiRes, err := ParseAddRolePrivilegesResponse(rsp)
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
-		return nil, err
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "INFA-SESSION-ID", runtime.ParamLocationHeader, params.INFASESSIONID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("INFA-SESSION-ID", headerParam0)
+
 	}
-	return apiRes, nil
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) AddRolePrivilegesWithResponse(ctx context.Context, roleRef PathRole, params *AddRolePrivilegesParams, body AddRolePrivilegesJSONRequestBody, editors ...common.ClientConfigEditor) (*AddRolePrivilegesResponse, error) {
-	rsp, err := c.AddRolePrivileges(ctx, roleRef, params, body, editors...)
+// NewRemoveRolePrivilegesRequest calls the generic RemoveRolePrivileges builder with application/json body
+func NewRemoveRolePrivilegesRequest(server string, roleRef PathRole, params *RemoveRolePrivilegesParams, body RemoveRolePrivilegesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	apiRes, err := ParseAddRolePrivilegesResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewRemoveRolePrivilegesRequestWithBody(server, roleRef, params, "application/json", bodyReader)
+}
+
+// NewRemoveRolePrivilegesRequestWithBody generates requests for RemoveRolePrivileges with any type of body
+func NewRemoveRolePrivilegesRequestWithBody(server string, roleRef PathRole, params *RemoveRolePrivilegesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "role_ref", runtime.ParamLocationPath, roleRef)
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
 		return nil, err
 	}
-	return apiRes, nil
-}
 
-// RemoveRolePrivilegesWithBodyWithResponse request with arbitrary body returning *RemoveRolePrivilegesResponse
-func (c *ClientWithResponses) RemoveRolePrivilegesWithBodyWithResponse(ctx context.Context, roleRef PathRole, params *RemoveRolePrivilegesParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*RemoveRolePrivilegesResponse, error) {
-	rsp, err := c.RemoveRolePrivilegesWithBody(ctx, roleRef, params, contentType, body, editors...)
+	operationPath := fmt.Sprintf("/public/core/v3/roles/%s/removePrivileges", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	apiRes, err := ParseRemoveRolePrivilegesResponse(rsp)
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "INFA-SESSION-ID", runtime.ParamLocationHeader, params.INFASESSIONID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("INFA-SESSION-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteSamlConfigRequest generates requests for DeleteSamlConfig
+func NewDeleteSamlConfigRequest(server string, params *DeleteSamlConfigParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/public/core/v3/samlConfig")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "INFA-SESSION-ID", runtime.ParamLocationHeader, params.INFASESSIONID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("INFA-SESSION-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetSamlConfigRequest generates requests for GetSamlConfig
+func NewGetSamlConfigRequest(server string, params *GetSamlConfigParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/public/core/v3/samlConfig")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "INFA-SESSION-ID", runtime.ParamLocationHeader, params.INFASESSIONID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("INFA-SESSION-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewUpdateSamlConfigRequest calls the generic UpdateSamlConfig builder with application/json body
+func NewUpdateSamlConfigRequest(server string, params *UpdateSamlConfigParams, body UpdateSamlConfigJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSamlConfigRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewUpdateSamlConfigRequestWithBody generates requests for UpdateSamlConfig with any type of body
+func NewUpdateSamlConfigRequestWithBody(server string, params *UpdateSamlConfigParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/public/core/v3/samlConfig")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "INFA-SESSION-ID", runtime.ParamLocationHeader, params.INFASESSIONID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("INFA-SESSION-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetSamlSpMetadataRequest generates requests for GetSamlSpMetadata
+func NewGetSamlSpMetadataRequest(server string, params *GetSamlSpMetadataParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/public/core/v3/samlConfig/spMetadata")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "INFA-SESSION-ID", runtime.ParamLocationHeader, params.INFASESSIONID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("INFA-SESSION-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewUnpublishAssetsRequest calls the generic UnpublishAssets builder with application/json body
+func NewUnpublishAssetsRequest(server string, params *UnpublishAssetsParams, body UnpublishAssetsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUnpublishAssetsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewUnpublishAssetsRequestWithBody generates requests for UnpublishAssets with any type of body
+func NewUnpublishAssetsRequestWithBody(server string, params *UnpublishAssetsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/public/core/v3/unpublish")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "INFA-SESSION-ID", runtime.ParamLocationHeader, params.INFASESSIONID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("INFA-SESSION-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetUnpublishJobRequest generates requests for GetUnpublishJob
+func NewGetUnpublishJobRequest(server string, jobId PathPublishJob, params *GetUnpublishJobParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/public/core/v3/unpublish/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "INFA-SESSION-ID", runtime.ParamLocationHeader, params.INFASESSIONID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("INFA-SESSION-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// </editor-fold> //////////////////////////////////////////////////////////////
+// <editor-fold desc="client-with-responses" defaultstate="collapsed"> /////////
+
+// ClientWithResponses builds on Client to offer response payloads
+type ClientWithResponses struct {
+	*Client
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...common.ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetTrustedIpRangesWithResponse request
+	GetTrustedIpRangesWithResponse(ctx context.Context, params *GetTrustedIpRangesParams, editors ...common.ClientConfigEditor) (*GetTrustedIpRangesResponse, error)
+
+	// UpdateTrustedIpRangesWithBodyWithResponse request with any body
+	UpdateTrustedIpRangesWithBodyWithResponse(ctx context.Context, params *UpdateTrustedIpRangesParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateTrustedIpRangesResponse, error)
+
+	UpdateTrustedIpRangesWithResponse(ctx context.Context, params *UpdateTrustedIpRangesParams, body UpdateTrustedIpRangesJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateTrustedIpRangesResponse, error)
+
+	// ControlAgentServiceWithBodyWithResponse request with any body
+	ControlAgentServiceWithBodyWithResponse(ctx context.Context, params *ControlAgentServiceParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*ControlAgentServiceResponse, error)
+
+	ControlAgentServiceWithResponse(ctx context.Context, params *ControlAgentServiceParams, body ControlAgentServiceJSONRequestBody, editors ...common.ClientConfigEditor) (*ControlAgentServiceResponse, error)
+
+	// LoginWithBodyWithResponse request with any body
+	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*LoginResponse, error)
+
+	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, editors ...common.ClientConfigEditor) (*LoginResponse, error)
+
+	// LogoutWithResponse request
+	LogoutWithResponse(ctx context.Context, params *LogoutParams, editors ...common.ClientConfigEditor) (*LogoutResponse, error)
+
+	// LookupObjectsWithBodyWithResponse request with any body
+	LookupObjectsWithBodyWithResponse(ctx context.Context, params *LookupObjectsParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*LookupObjectsResponse, error)
+
+	LookupObjectsWithResponse(ctx context.Context, params *LookupObjectsParams, body LookupObjectsJSONRequestBody, editors ...common.ClientConfigEditor) (*LookupObjectsResponse, error)
+
+	// ListPrivilegesWithResponse request
+	ListPrivilegesWithResponse(ctx context.Context, params *ListPrivilegesParams, editors ...common.ClientConfigEditor) (*ListPrivilegesResponse, error)
+
+	// PublishAssetsWithBodyWithResponse request with any body
+	PublishAssetsWithBodyWithResponse(ctx context.Context, params *PublishAssetsParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*PublishAssetsResponse, error)
+
+	PublishAssetsWithResponse(ctx context.Context, params *PublishAssetsParams, body PublishAssetsJSONRequestBody, editors ...common.ClientConfigEditor) (*PublishAssetsResponse, error)
+
+	// GetPublishJobWithResponse request
+	GetPublishJobWithResponse(ctx context.Context, jobId PathPublishJob, params *GetPublishJobParams, editors ...common.ClientConfigEditor) (*GetPublishJobResponse, error)
+
+	// GetRolesWithResponse request
+	GetRolesWithResponse(ctx context.Context, params *GetRolesParams, editors ...common.ClientConfigEditor) (*GetRolesResponse, error)
+
+	// CreateRoleWithBodyWithResponse request with any body
+	CreateRoleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*CreateRoleResponse, error)
+
+	CreateRoleWithResponse(ctx context.Context, body CreateRoleJSONRequestBody, editors ...common.ClientConfigEditor) (*CreateRoleResponse, error)
+
+	// DeleteRoleWithResponse request
+	DeleteRoleWithResponse(ctx context.Context, roleRef PathRole, params *DeleteRoleParams, editors ...common.ClientConfigEditor) (*DeleteRoleResponse, error)
+
+	// UpdateRoleWithBodyWithResponse request with any body
+	UpdateRoleWithBodyWithResponse(ctx context.Context, roleRef PathRole, params *UpdateRoleParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateRoleResponse, error)
+
+	UpdateRoleWithResponse(ctx context.Context, roleRef PathRole, params *UpdateRoleParams, body UpdateRoleJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateRoleResponse, error)
+
+	// AddRolePrivilegesWithBodyWithResponse request with any body
+	AddRolePrivilegesWithBodyWithResponse(ctx context.Context, roleRef PathRole, params *AddRolePrivilegesParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*AddRolePrivilegesResponse, error)
+
+	AddRolePrivilegesWithResponse(ctx context.Context, roleRef PathRole, params *AddRolePrivilegesParams, body AddRolePrivilegesJSONRequestBody, editors ...common.ClientConfigEditor) (*AddRolePrivilegesResponse, error)
+
+	// RemoveRolePrivilegesWithBodyWithResponse request with any body
+	RemoveRolePrivilegesWithBodyWithResponse(ctx context.Context, roleRef PathRole, params *RemoveRolePrivilegesParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*RemoveRolePrivilegesResponse, error)
+
+	RemoveRolePrivilegesWithResponse(ctx context.Context, roleRef PathRole, params *RemoveRolePrivilegesParams, body RemoveRolePrivilegesJSONRequestBody, editors ...common.ClientConfigEditor) (*RemoveRolePrivilegesResponse, error)
+
+	// DeleteSamlConfigWithResponse request
+	DeleteSamlConfigWithResponse(ctx context.Context, params *DeleteSamlConfigParams, editors ...common.ClientConfigEditor) (*DeleteSamlConfigResponse, error)
+
+	// GetSamlConfigWithResponse request
+	GetSamlConfigWithResponse(ctx context.Context, params *GetSamlConfigParams, editors ...common.ClientConfigEditor) (*GetSamlConfigResponse, error)
+
+	// UpdateSamlConfigWithBodyWithResponse request with any body
+	UpdateSamlConfigWithBodyWithResponse(ctx context.Context, params *UpdateSamlConfigParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateSamlConfigResponse, error)
+
+	UpdateSamlConfigWithResponse(ctx context.Context, params *UpdateSamlConfigParams, body UpdateSamlConfigJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateSamlConfigResponse, error)
+
+	// GetSamlSpMetadataWithResponse request
+	GetSamlSpMetadataWithResponse(ctx context.Context, params *GetSamlSpMetadataParams, editors ...common.ClientConfigEditor) (*GetSamlSpMetadataResponse, error)
+
+	// UnpublishAssetsWithBodyWithResponse request with any body
+	UnpublishAssetsWithBodyWithResponse(ctx context.Context, params *UnpublishAssetsParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UnpublishAssetsResponse, error)
+
+	UnpublishAssetsWithResponse(ctx context.Context, params *UnpublishAssetsParams, body UnpublishAssetsJSONRequestBody, editors ...common.ClientConfigEditor) (*UnpublishAssetsResponse, error)
+
+	// GetUnpublishJobWithResponse request
+	GetUnpublishJobWithResponse(ctx context.Context, jobId PathPublishJob, params *GetUnpublishJobParams, editors ...common.ClientConfigEditor) (*GetUnpublishJobResponse, error)
+}
+
+type GetTrustedIpRangesResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *TrustedIpRanges
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTrustedIpRangesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTrustedIpRangesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r GetTrustedIpRangesResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r GetTrustedIpRangesResponse) BodyData() []byte {
+	return r.Body
+}
+
+type UpdateTrustedIpRangesResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *TrustedIpRanges
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTrustedIpRangesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTrustedIpRangesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r UpdateTrustedIpRangesResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r UpdateTrustedIpRangesResponse) BodyData() []byte {
+	return r.Body
+}
+
+type ControlAgentServiceResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *AgentServiceResponseBody
+}
+
+// Status returns HTTPResponse.Status
+func (r ControlAgentServiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ControlAgentServiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r ControlAgentServiceResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r ControlAgentServiceResponse) BodyData() []byte {
+	return r.Body
+}
+
+type LoginResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *LoginResponseBody
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r LoginResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r LoginResponse) BodyData() []byte {
+	return r.Body
+}
+
+type LogoutResponse struct {
+	common.IdmcClientResponse[N400]
+}
+
+// Status returns HTTPResponse.Status
+func (r LogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r LogoutResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r LogoutResponse) BodyData() []byte {
+	return r.Body
+}
+
+type LookupObjectsResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *LookupResponseBody
+}
+
+// Status returns HTTPResponse.Status
+func (r LookupObjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LookupObjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r LookupObjectsResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r LookupObjectsResponse) BodyData() []byte {
+	return r.Body
+}
+
+type ListPrivilegesResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *[]RolePrivilegeItem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPrivilegesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPrivilegesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r ListPrivilegesResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r ListPrivilegesResponse) BodyData() []byte {
+	return r.Body
+}
+
+type PublishAssetsResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *PublishJob
+}
+
+// Status returns HTTPResponse.Status
+func (r PublishAssetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PublishAssetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r PublishAssetsResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r PublishAssetsResponse) BodyData() []byte {
+	return r.Body
+}
+
+type GetPublishJobResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *PublishJob
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPublishJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPublishJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r GetPublishJobResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r GetPublishJobResponse) BodyData() []byte {
+	return r.Body
+}
+
+type GetRolesResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *GetRolesResponseBody
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRolesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRolesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r GetRolesResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r GetRolesResponse) BodyData() []byte {
+	return r.Body
+}
+
+type CreateRoleResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON201 *CreateRoleResponseBody
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r CreateRoleResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r CreateRoleResponse) BodyData() []byte {
+	return r.Body
+}
+
+type DeleteRoleResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON204 *N204
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r DeleteRoleResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r DeleteRoleResponse) BodyData() []byte {
+	return r.Body
+}
+
+type UpdateRoleResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *RoleInfo
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateRoleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateRoleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r UpdateRoleResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r UpdateRoleResponse) BodyData() []byte {
+	return r.Body
+}
+
+type AddRolePrivilegesResponse struct {
+	common.IdmcClientResponse[N400]
+}
+
+// Status returns HTTPResponse.Status
+func (r AddRolePrivilegesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddRolePrivilegesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r AddRolePrivilegesResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r AddRolePrivilegesResponse) BodyData() []byte {
+	return r.Body
+}
+
+type RemoveRolePrivilegesResponse struct {
+	common.IdmcClientResponse[N400]
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveRolePrivilegesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveRolePrivilegesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r RemoveRolePrivilegesResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r RemoveRolePrivilegesResponse) BodyData() []byte {
+	return r.Body
+}
+
+type DeleteSamlConfigResponse struct {
+	common.IdmcClientResponse[N400]
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSamlConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSamlConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r DeleteSamlConfigResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r DeleteSamlConfigResponse) BodyData() []byte {
+	return r.Body
+}
+
+type GetSamlConfigResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *SamlConfig
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSamlConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSamlConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r GetSamlConfigResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r GetSamlConfigResponse) BodyData() []byte {
+	return r.Body
+}
+
+type UpdateSamlConfigResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *SamlConfig
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSamlConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSamlConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r UpdateSamlConfigResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r UpdateSamlConfigResponse) BodyData() []byte {
+	return r.Body
+}
+
+type GetSamlSpMetadataResponse struct {
+	common.IdmcClientResponse[N400]
+	XML200 *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSamlSpMetadataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSamlSpMetadataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r GetSamlSpMetadataResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r GetSamlSpMetadataResponse) BodyData() []byte {
+	return r.Body
+}
+
+type UnpublishAssetsResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *PublishJob
+}
+
+// Status returns HTTPResponse.Status
+func (r UnpublishAssetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnpublishAssetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r UnpublishAssetsResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r UnpublishAssetsResponse) BodyData() []byte {
+	return r.Body
+}
+
+type GetUnpublishJobResponse struct {
+	common.IdmcClientResponse[N400]
+	JSON200 *PublishJob
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUnpublishJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUnpublishJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// HttpResponse returns HTTPResponse
+func (r GetUnpublishJobResponse) HttpResponse() *http.Response {
+	return r.HTTPResponse
+}
+
+// BodyData returns HTTPResponse.Body
+func (r GetUnpublishJobResponse) BodyData() []byte {
+	return r.Body
+}
+
+// GetTrustedIpRangesWithResponse request returning *GetTrustedIpRangesResponse
+func (c *ClientWithResponses) GetTrustedIpRangesWithResponse(ctx context.Context, params *GetTrustedIpRangesParams, editors ...common.ClientConfigEditor) (*GetTrustedIpRangesResponse, error) {
+	rsp, err := c.GetTrustedIpRanges(ctx, params, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseGetTrustedIpRangesResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// UpdateTrustedIpRangesWithBodyWithResponse request with arbitrary body returning *UpdateTrustedIpRangesResponse
+func (c *ClientWithResponses) UpdateTrustedIpRangesWithBodyWithResponse(ctx context.Context, params *UpdateTrustedIpRangesParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateTrustedIpRangesResponse, error) {
+	rsp, err := c.UpdateTrustedIpRangesWithBody(ctx, params, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateTrustedIpRangesResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) UpdateTrustedIpRangesWithResponse(ctx context.Context, params *UpdateTrustedIpRangesParams, body UpdateTrustedIpRangesJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateTrustedIpRangesResponse, error) {
+	rsp, err := c.UpdateTrustedIpRanges(ctx, params, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateTrustedIpRangesResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// ControlAgentServiceWithBodyWithResponse request with arbitrary body returning *ControlAgentServiceResponse
+func (c *ClientWithResponses) ControlAgentServiceWithBodyWithResponse(ctx context.Context, params *ControlAgentServiceParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*ControlAgentServiceResponse, error) {
+	rsp, err := c.ControlAgentServiceWithBody(ctx, params, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseControlAgentServiceResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) ControlAgentServiceWithResponse(ctx context.Context, params *ControlAgentServiceParams, body ControlAgentServiceJSONRequestBody, editors ...common.ClientConfigEditor) (*ControlAgentServiceResponse, error) {
+	rsp, err := c.ControlAgentService(ctx, params, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseControlAgentServiceResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
+func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*LoginResponse, error) {
+	rsp, err := c.LoginWithBody(ctx, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseLoginResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, editors ...common.ClientConfigEditor) (*LoginResponse, error) {
+	rsp, err := c.Login(ctx, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseLoginResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// LogoutWithResponse request returning *LogoutResponse
+func (c *ClientWithResponses) LogoutWithResponse(ctx context.Context, params *LogoutParams, editors ...common.ClientConfigEditor) (*LogoutResponse, error) {
+	rsp, err := c.Logout(ctx, params, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseLogoutResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// LookupObjectsWithBodyWithResponse request with arbitrary body returning *LookupObjectsResponse
+func (c *ClientWithResponses) LookupObjectsWithBodyWithResponse(ctx context.Context, params *LookupObjectsParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*LookupObjectsResponse, error) {
+	rsp, err := c.LookupObjectsWithBody(ctx, params, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseLookupObjectsResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) LookupObjectsWithResponse(ctx context.Context, params *LookupObjectsParams, body LookupObjectsJSONRequestBody, editors ...common.ClientConfigEditor) (*LookupObjectsResponse, error) {
+	rsp, err := c.LookupObjects(ctx, params, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseLookupObjectsResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// ListPrivilegesWithResponse request returning *ListPrivilegesResponse
+func (c *ClientWithResponses) ListPrivilegesWithResponse(ctx context.Context, params *ListPrivilegesParams, editors ...common.ClientConfigEditor) (*ListPrivilegesResponse, error) {
+	rsp, err := c.ListPrivileges(ctx, params, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseListPrivilegesResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// PublishAssetsWithBodyWithResponse request with arbitrary body returning *PublishAssetsResponse
+func (c *ClientWithResponses) PublishAssetsWithBodyWithResponse(ctx context.Context, params *PublishAssetsParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*PublishAssetsResponse, error) {
+	rsp, err := c.PublishAssetsWithBody(ctx, params, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParsePublishAssetsResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) PublishAssetsWithResponse(ctx context.Context, params *PublishAssetsParams, body PublishAssetsJSONRequestBody, editors ...common.ClientConfigEditor) (*PublishAssetsResponse, error) {
+	rsp, err := c.PublishAssets(ctx, params, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParsePublishAssetsResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// GetPublishJobWithResponse request returning *GetPublishJobResponse
+func (c *ClientWithResponses) GetPublishJobWithResponse(ctx context.Context, jobId PathPublishJob, params *GetPublishJobParams, editors ...common.ClientConfigEditor) (*GetPublishJobResponse, error) {
+	rsp, err := c.GetPublishJob(ctx, jobId, params, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseGetPublishJobResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// GetRolesWithResponse request returning *GetRolesResponse
+func (c *ClientWithResponses) GetRolesWithResponse(ctx context.Context, params *GetRolesParams, editors ...common.ClientConfigEditor) (*GetRolesResponse, error) {
+	rsp, err := c.GetRoles(ctx, params, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseGetRolesResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// CreateRoleWithBodyWithResponse request with arbitrary body returning *CreateRoleResponse
+func (c *ClientWithResponses) CreateRoleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*CreateRoleResponse, error) {
+	rsp, err := c.CreateRoleWithBody(ctx, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseCreateRoleResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) CreateRoleWithResponse(ctx context.Context, body CreateRoleJSONRequestBody, editors ...common.ClientConfigEditor) (*CreateRoleResponse, error) {
+	rsp, err := c.CreateRole(ctx, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseCreateRoleResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// DeleteRoleWithResponse request returning *DeleteRoleResponse
+func (c *ClientWithResponses) DeleteRoleWithResponse(ctx context.Context, roleRef PathRole, params *DeleteRoleParams, editors ...common.ClientConfigEditor) (*DeleteRoleResponse, error) {
+	rsp, err := c.DeleteRole(ctx, roleRef, params, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseDeleteRoleResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// UpdateRoleWithBodyWithResponse request with arbitrary body returning *UpdateRoleResponse
+func (c *ClientWithResponses) UpdateRoleWithBodyWithResponse(ctx context.Context, roleRef PathRole, params *UpdateRoleParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateRoleResponse, error) {
+	rsp, err := c.UpdateRoleWithBody(ctx, roleRef, params, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateRoleResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) UpdateRoleWithResponse(ctx context.Context, roleRef PathRole, params *UpdateRoleParams, body UpdateRoleJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateRoleResponse, error) {
+	rsp, err := c.UpdateRole(ctx, roleRef, params, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateRoleResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// AddRolePrivilegesWithBodyWithResponse request with arbitrary body returning *AddRolePrivilegesResponse
+func (c *ClientWithResponses) AddRolePrivilegesWithBodyWithResponse(ctx context.Context, roleRef PathRole, params *AddRolePrivilegesParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*AddRolePrivilegesResponse, error) {
+	rsp, err := c.AddRolePrivilegesWithBody(ctx, roleRef, params, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseAddRolePrivilegesResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) AddRolePrivilegesWithResponse(ctx context.Context, roleRef PathRole, params *AddRolePrivilegesParams, body AddRolePrivilegesJSONRequestBody, editors ...common.ClientConfigEditor) (*AddRolePrivilegesResponse, error) {
+	rsp, err := c.AddRolePrivileges(ctx, roleRef, params, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseAddRolePrivilegesResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// RemoveRolePrivilegesWithBodyWithResponse request with arbitrary body returning *RemoveRolePrivilegesResponse
+func (c *ClientWithResponses) RemoveRolePrivilegesWithBodyWithResponse(ctx context.Context, roleRef PathRole, params *RemoveRolePrivilegesParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*RemoveRolePrivilegesResponse, error) {
+	rsp, err := c.RemoveRolePrivilegesWithBody(ctx, roleRef, params, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseRemoveRolePrivilegesResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) RemoveRolePrivilegesWithResponse(ctx context.Context, roleRef PathRole, params *RemoveRolePrivilegesParams, body RemoveRolePrivilegesJSONRequestBody, editors ...common.ClientConfigEditor) (*RemoveRolePrivilegesResponse, error) {
+	rsp, err := c.RemoveRolePrivileges(ctx, roleRef, params, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseRemoveRolePrivilegesResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// DeleteSamlConfigWithResponse request returning *DeleteSamlConfigResponse
+func (c *ClientWithResponses) DeleteSamlConfigWithResponse(ctx context.Context, params *DeleteSamlConfigParams, editors ...common.ClientConfigEditor) (*DeleteSamlConfigResponse, error) {
+	rsp, err := c.DeleteSamlConfig(ctx, params, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseDeleteSamlConfigResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// GetSamlConfigWithResponse request returning *GetSamlConfigResponse
+func (c *ClientWithResponses) GetSamlConfigWithResponse(ctx context.Context, params *GetSamlConfigParams, editors ...common.ClientConfigEditor) (*GetSamlConfigResponse, error) {
+	rsp, err := c.GetSamlConfig(ctx, params, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseGetSamlConfigResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// UpdateSamlConfigWithBodyWithResponse request with arbitrary body returning *UpdateSamlConfigResponse
+func (c *ClientWithResponses) UpdateSamlConfigWithBodyWithResponse(ctx context.Context, params *UpdateSamlConfigParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UpdateSamlConfigResponse, error) {
+	rsp, err := c.UpdateSamlConfigWithBody(ctx, params, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateSamlConfigResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) UpdateSamlConfigWithResponse(ctx context.Context, params *UpdateSamlConfigParams, body UpdateSamlConfigJSONRequestBody, editors ...common.ClientConfigEditor) (*UpdateSamlConfigResponse, error) {
+	rsp, err := c.UpdateSamlConfig(ctx, params, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUpdateSamlConfigResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// GetSamlSpMetadataWithResponse request returning *GetSamlSpMetadataResponse
+func (c *ClientWithResponses) GetSamlSpMetadataWithResponse(ctx context.Context, params *GetSamlSpMetadataParams, editors ...common.ClientConfigEditor) (*GetSamlSpMetadataResponse, error) {
+	rsp, err := c.GetSamlSpMetadata(ctx, params, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseGetSamlSpMetadataResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// UnpublishAssetsWithBodyWithResponse request with arbitrary body returning *UnpublishAssetsResponse
+func (c *ClientWithResponses) UnpublishAssetsWithBodyWithResponse(ctx context.Context, params *UnpublishAssetsParams, contentType string, body io.Reader, editors ...common.ClientConfigEditor) (*UnpublishAssetsResponse, error) {
+	rsp, err := c.UnpublishAssetsWithBody(ctx, params, contentType, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUnpublishAssetsResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+func (c *ClientWithResponses) UnpublishAssetsWithResponse(ctx context.Context, params *UnpublishAssetsParams, body UnpublishAssetsJSONRequestBody, editors ...common.ClientConfigEditor) (*UnpublishAssetsResponse, error) {
+	rsp, err := c.UnpublishAssets(ctx, params, body, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseUnpublishAssetsResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// GetUnpublishJobWithResponse request returning *GetUnpublishJobResponse
+func (c *ClientWithResponses) GetUnpublishJobWithResponse(ctx context.Context, jobId PathPublishJob, params *GetUnpublishJobParams, editors ...common.ClientConfigEditor) (*GetUnpublishJobResponse, error) {
+	rsp, err := c.GetUnpublishJob(ctx, jobId, params, editors...)
+	if err != nil {
+		return nil, err
+	}
+	apiRes, err := ParseGetUnpublishJobResponse(rsp)
+	if err != nil {
+		return nil, err
+	}
+	editor := c.Editors.Merge(editors...)
+	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+		return nil, err
+	}
+	return apiRes, nil
+}
+
+// ParseGetTrustedIpRangesResponse parses an HTTP response from a GetTrustedIpRangesWithResponse call
+func ParseGetTrustedIpRangesResponse(rsp *http.Response) (*GetTrustedIpRangesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTrustedIpRangesResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TrustedIpRanges
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTrustedIpRangesResponse parses an HTTP response from a UpdateTrustedIpRangesWithResponse call
+func ParseUpdateTrustedIpRangesResponse(rsp *http.Response) (*UpdateTrustedIpRangesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTrustedIpRangesResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TrustedIpRanges
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseControlAgentServiceResponse parses an HTTP response from a ControlAgentServiceWithResponse call
+func ParseControlAgentServiceResponse(rsp *http.Response) (*ControlAgentServiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ControlAgentServiceResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AgentServiceResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
+func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLogoutResponse parses an HTTP response from a LogoutWithResponse call
+func ParseLogoutResponse(rsp *http.Response) (*LogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogoutResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLookupObjectsResponse parses an HTTP response from a LookupObjectsWithResponse call
+func ParseLookupObjectsResponse(rsp *http.Response) (*LookupObjectsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LookupObjectsResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LookupResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPrivilegesResponse parses an HTTP response from a ListPrivilegesWithResponse call
+func ParseListPrivilegesResponse(rsp *http.Response) (*ListPrivilegesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPrivilegesResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RolePrivilegeItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePublishAssetsResponse parses an HTTP response from a PublishAssetsWithResponse call
+func ParsePublishAssetsResponse(rsp *http.Response) (*PublishAssetsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PublishAssetsResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PublishJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPublishJobResponse parses an HTTP response from a GetPublishJobWithResponse call
+func ParseGetPublishJobResponse(rsp *http.Response) (*GetPublishJobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
+
+	response := &GetPublishJobResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PublishJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRolesResponse parses an HTTP response from a GetRolesWithResponse call
+func ParseGetRolesResponse(rsp *http.Response) (*GetRolesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
 		return nil, err
 	}
-	return apiRes, nil
+
+	response := &GetRolesResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetRolesResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateRoleResponse parses an HTTP response from a CreateRoleWithResponse call
+func ParseCreateRoleResponse(rsp *http.Response) (*CreateRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateRoleResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateRoleResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) RemoveRolePrivilegesWithResponse(ctx context.Context, roleRef PathRole, params *RemoveRolePrivilegesParams, body RemoveRolePrivilegesJSONRequestBody, editors ...common.ClientConfigEditor) (*RemoveRolePrivilegesResponse, error) {
-	rsp, err := c.RemoveRolePrivileges(ctx, roleRef, params, body, editors...)
-	if err != nil {
-		return nil, err
-	}
-	apiRes, err := ParseRemoveRolePrivilegesResponse(rsp)
+// ParseDeleteRoleResponse parses an HTTP response from a DeleteRoleWithResponse call
+func ParseDeleteRoleResponse(rsp *http.Response) (*DeleteRoleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	editor := c.Editors.Merge(editors...)
-	if err := editor.EditApiResponse(ctx, &apiRes.ClientResponse); err != nil {
-		return nil, err
+
+	response := &DeleteRoleResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 204:
+		var dest N204
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON204 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
-	return apiRes, nil
+
+	return response, nil
 }
 
-// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
-func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
+// ParseUpdateRoleResponse parses an HTTP response from a UpdateRoleWithResponse call
+func ParseUpdateRoleResponse(rsp *http.Response) (*UpdateRoleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &LoginResponse{
-		ClientResponse: common.ClientResponse{
-			Body:         bodyBytes,
-			HTTPResponse: rsp,
-		},
-	}
+	response := &UpdateRoleResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LoginResponseBody
+		var dest RoleInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1466,29 +4368,86 @@ func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
 	return response, nil
 }
 
-// ParseListPrivilegesResponse parses an HTTP response from a ListPrivilegesWithResponse call
-func ParseListPrivilegesResponse(rsp *http.Response) (*ListPrivilegesResponse, error) {
+// ParseAddRolePrivilegesResponse parses an HTTP response from a AddRolePrivilegesWithResponse call
+func ParseAddRolePrivilegesResponse(rsp *http.Response) (*AddRolePrivilegesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListPrivilegesResponse{
-		ClientResponse: common.ClientResponse{
-			Body:         bodyBytes,
-			HTTPResponse: rsp,
-		},
-	}
+	response := &AddRolePrivilegesResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []RolePrivilegeItem
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveRolePrivilegesResponse parses an HTTP response from a RemoveRolePrivilegesWithResponse call
+func ParseRemoveRolePrivilegesResponse(rsp *http.Response) (*RemoveRolePrivilegesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveRolePrivilegesResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
 
+	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1543,24 +4502,88 @@ func ParseListPrivilegesResponse(rsp *http.Response) (*ListPrivilegesResponse, e
 	return response, nil
 }
 
-// ParseGetRolesResponse parses an HTTP response from a GetRolesWithResponse call
-func ParseGetRolesResponse(rsp *http.Response) (*GetRolesResponse, error) {
+// ParseDeleteSamlConfigResponse parses an HTTP response from a DeleteSamlConfigWithResponse call
+func ParseDeleteSamlConfigResponse(rsp *http.Response) (*DeleteSamlConfigResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRolesResponse{
-		ClientResponse: common.ClientResponse{
-			Body:         bodyBytes,
-			HTTPResponse: rsp,
-		},
+	response := &DeleteSamlConfigResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest N400
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest N401
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest N403
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest N404
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest N500
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest N502
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest N503
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSamlConfigResponse parses an HTTP response from a GetSamlConfigWithResponse call
+func ParseGetSamlConfigResponse(rsp *http.Response) (*GetSamlConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
 
+	response := &GetSamlConfigResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
+
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GetRolesResponseBody
+		var dest SamlConfig
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1620,28 +4643,25 @@ func ParseGetRolesResponse(rsp *http.Response) (*GetRolesResponse, error) {
 	return response, nil
 }
 
-// ParseCreateRoleResponse parses an HTTP response from a CreateRoleWithResponse call
-func ParseCreateRoleResponse(rsp *http.Response) (*CreateRoleResponse, error) {
+// ParseUpdateSamlConfigResponse parses an HTTP response from a UpdateSamlConfigWithResponse call
+func ParseUpdateSamlConfigResponse(rsp *http.Response) (*UpdateSamlConfigResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateRoleResponse{
-		ClientResponse: common.ClientResponse{
-			Body:         bodyBytes,
-			HTTPResponse: rsp,
-		},
-	}
+	response := &UpdateSamlConfigResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CreateRoleResponseBody
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SamlConfig
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
@@ -1697,29 +4717,19 @@ func ParseCreateRoleResponse(rsp *http.Response) (*CreateRoleResponse, error) {
 	return response, nil
 }
 
-// ParseDeleteRoleResponse parses an HTTP response from a DeleteRoleWithResponse call
-func ParseDeleteRoleResponse(rsp *http.Response) (*DeleteRoleResponse, error) {
+// ParseGetSamlSpMetadataResponse parses an HTTP response from a GetSamlSpMetadataWithResponse call
+func ParseGetSamlSpMetadataResponse(rsp *http.Response) (*GetSamlSpMetadataResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteRoleResponse{
-		ClientResponse: common.ClientResponse{
-			Body:         bodyBytes,
-			HTTPResponse: rsp,
-		},
-	}
+	response := &GetSamlSpMetadataResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 204:
-		var dest N204
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON204 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1769,27 +4779,38 @@ func ParseDeleteRoleResponse(rsp *http.Response) (*DeleteRoleResponse, error) {
 		}
 		response.JSON503 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
+		var dest string
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML200 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseAddRolePrivilegesResponse parses an HTTP response from a AddRolePrivilegesWithResponse call
-func ParseAddRolePrivilegesResponse(rsp *http.Response) (*AddRolePrivilegesResponse, error) {
+// ParseUnpublishAssetsResponse parses an HTTP response from a UnpublishAssetsWithResponse call
+func ParseUnpublishAssetsResponse(rsp *http.Response) (*UnpublishAssetsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddRolePrivilegesResponse{
-		ClientResponse: common.ClientResponse{
-			Body:         bodyBytes,
-			HTTPResponse: rsp,
-		},
-	}
+	response := &UnpublishAssetsResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PublishJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1844,22 +4865,26 @@ func ParseAddRolePrivilegesResponse(rsp *http.Response) (*AddRolePrivilegesRespo
 	return response, nil
 }
 
-// ParseRemoveRolePrivilegesResponse parses an HTTP response from a RemoveRolePrivilegesWithResponse call
-func ParseRemoveRolePrivilegesResponse(rsp *http.Response) (*RemoveRolePrivilegesResponse, error) {
+// ParseGetUnpublishJobResponse parses an HTTP response from a GetUnpublishJobWithResponse call
+func ParseGetUnpublishJobResponse(rsp *http.Response) (*GetUnpublishJobResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RemoveRolePrivilegesResponse{
-		ClientResponse: common.ClientResponse{
-			Body:         bodyBytes,
-			HTTPResponse: rsp,
-		},
-	}
+	response := &GetUnpublishJobResponse{}
+	response.Body = bodyBytes
+	response.HTTPResponse = rsp
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PublishJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest N400
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/common"
+	"terraform-provider-idmc/internal/idmc/v2"
+	"terraform-provider-idmc/internal/utils"
+
+	. "github.com/hashicorp/terraform-plugin-framework/datasource"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+var _ DataSourceWithConfigure = &ActivityLogDataSource{}
+
+type ActivityLogDataSource struct {
+	*IdmcProviderDataSource
+}
+
+func NewActivityLogDataSource() DataSource {
+	return &ActivityLogDataSource{
+		&IdmcProviderDataSource{},
+	}
+}
+
+type ActivityLogDataSourceModel struct {
+	StartTime  timetypes.RFC3339 `tfsdk:"start_time"`
+	EndTime    timetypes.RFC3339 `tfsdk:"end_time"`
+	User       types.String      `tfsdk:"user"`
+	TaskId     types.String      `tfsdk:"task_id"`
+	MaxResults types.Int64       `tfsdk:"max_results"`
+	Entries    types.List        `tfsdk:"entries"`
+}
+
+func (d *ActivityLogDataSource) Metadata(_ context.Context, req MetadataRequest, resp *MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_activity_log"
+}
+
+func (d *ActivityLogDataSource) Schema(_ context.Context, _ SchemaRequest, resp *SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "https://docs.informatica.com/integration-cloud/data-integration/current-version/rest-api-reference/platform-rest-api-version-2-resources/activity.html",
+		Attributes: map[string]schema.Attribute{
+			"start_time": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Description: "Only include runs that started from this time onwards.",
+				Optional:    true,
+			},
+			"end_time": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Description: "Only include runs that started up to this time.",
+				Optional:    true,
+			},
+			"user": schema.StringAttribute{
+				Description: "Only include runs started by this user.",
+				Optional:    true,
+			},
+			"task_id": schema.StringAttribute{
+				Description: "Only include runs of this task.",
+				Optional:    true,
+			},
+			"max_results": schema.Int64Attribute{
+				Description: "The maximum number of entries to return, most recent first. " +
+					"Defaults to 200 when start_time isn't set, and is otherwise unlimited.",
+				Optional: true,
+			},
+			"entries": schema.ListNestedAttribute{
+				Description: "The matching entries, most recent first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Activity log entry ID.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the entry, such as 'MTT_ACTIVITY_LOG'.",
+							Computed:    true,
+						},
+						"task_id": schema.StringAttribute{
+							Description: "ID of the task that ran.",
+							Computed:    true,
+						},
+						"task_name": schema.StringAttribute{
+							Description: "Name of the task that ran.",
+							Computed:    true,
+						},
+						"run_id": schema.Int64Attribute{
+							Description: "ID of the run of the task.",
+							Computed:    true,
+						},
+						"runtime_environment_id": schema.StringAttribute{
+							Description: "ID of the runtime environment the task ran on.",
+							Computed:    true,
+						},
+						"started_by": schema.StringAttribute{
+							Description: "User or schedule that started the task.",
+							Computed:    true,
+						},
+						"start_time": schema.StringAttribute{
+							CustomType:  timetypes.RFC3339Type{},
+							Description: "When the run started.",
+							Computed:    true,
+						},
+						"end_time": schema.StringAttribute{
+							CustomType:  timetypes.RFC3339Type{},
+							Description: "When the run finished.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Outcome of the run: SUCCESS, WARNING, or FAILED.",
+							Computed:    true,
+						},
+						"success_source_rows": schema.Int64Attribute{
+							Description: "Number of rows successfully read from the source.",
+							Computed:    true,
+						},
+						"failed_source_rows": schema.Int64Attribute{
+							Description: "Number of rows that couldn't be read from the source.",
+							Computed:    true,
+						},
+						"success_target_rows": schema.Int64Attribute{
+							Description: "Number of rows successfully written to the target.",
+							Computed:    true,
+						},
+						"failed_target_rows": schema.Int64Attribute{
+							Description: "Number of rows that couldn't be written to the target.",
+							Computed:    true,
+						},
+						"error_message": schema.StringAttribute{
+							Description: "Why the run failed, if it did.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var activityLogEntryType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":                     types.StringType,
+		"type":                   types.StringType,
+		"task_id":                types.StringType,
+		"task_name":              types.StringType,
+		"run_id":                 types.Int64Type,
+		"runtime_environment_id": types.StringType,
+		"started_by":             types.StringType,
+		"start_time":             timetypes.RFC3339Type{},
+		"end_time":               timetypes.RFC3339Type{},
+		"status":                 types.StringType,
+		"success_source_rows":    types.Int64Type,
+		"failed_source_rows":     types.Int64Type,
+		"success_target_rows":    types.Int64Type,
+		"failed_target_rows":     types.Int64Type,
+		"error_message":          types.StringType,
+	},
+}
+
+func (d *ActivityLogDataSource) Read(ctx context.Context, req ReadRequest, resp *ReadResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgDataSourceBadRead)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := d.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load the previous state if present.
+	var config ActivityLogDataSourceModel
+	if diags.Append(req.Config.Get(ctx, &config)) {
+		return
+	}
+
+	filter := newLogFilter(diags, config.StartTime, config.EndTime, config.User, config.MaxResults)
+	if diags.HasError() {
+		return
+	}
+
+	// Only the task can be filtered by the api itself.
+	pages := common.NewPaginator(common.DefaultPageLimit, func(ctx context.Context, limit int32, skip int32) ([]v2.ActivityLogEntry, error) {
+		apiRes, apiErr := client.GetActivityLogWithResponse(ctx, &v2.GetActivityLogParams{
+			TaskId:   config.TaskId.ValueStringPointer(),
+			Offset:   &skip,
+			RowLimit: &limit,
+		})
+		if apiErr != nil {
+			return nil, apiErr
+		}
+
+		return utils.ValOr(apiRes.JSON200, nil), apiRes.RequireStatus(200)
+	})
+
+	entries, entriesErr := collectLogEntries(ctx, filter, pages,
+		func(entry v2.ActivityLogEntry) *time.Time { return parseLogTime(entry.StartTime) },
+		func(entry v2.ActivityLogEntry) *string { return entry.StartedBy },
+		func(entry v2.ActivityLogEntry) bool { return true },
+	)
+	if diags.HandleError(entriesErr) {
+		return
+	}
+
+	entriesDiags := diags.AtName("entries")
+	entryAttrs := make([]attr.Value, len(entries))
+	for index, entry := range entries {
+		entryDiags := entriesDiags.AtListIndex(index)
+		entryAttrs[index] = entryDiags.ObjectValue(activityLogEntryType.AttrTypes, map[string]attr.Value{
+			"id":                     types.StringPointerValue(entry.Id),
+			"type":                   types.StringPointerValue(entry.Type),
+			"task_id":                types.StringPointerValue(entry.ObjectId),
+			"task_name":              types.StringPointerValue(entry.ObjectName),
+			"run_id":                 types.Int64PointerValue(entry.RunId),
+			"runtime_environment_id": types.StringPointerValue(entry.RuntimeEnvironmentId),
+			"started_by":             types.StringPointerValue(entry.StartedBy),
+			"start_time":             entryDiags.AtName("start_time").TimePointer(entry.StartTime),
+			"end_time":               entryDiags.AtName("end_time").TimePointer(entry.EndTime),
+			"status":                 types.StringValue(jobRunStatus(entry.State)),
+			"success_source_rows":    types.Int64PointerValue(entry.SuccessSourceRows),
+			"failed_source_rows":     types.Int64PointerValue(entry.FailedSourceRows),
+			"success_target_rows":    types.Int64PointerValue(entry.SuccessTargetRows),
+			"failed_target_rows":     types.Int64PointerValue(entry.FailedTargetRows),
+			"error_message":          OptionalStringValue(entry.ErrorMsg),
+		})
+	}
+
+	config.Entries = entriesDiags.ListValue(activityLogEntryType, entryAttrs)
+	if diags.HasError() {
+		return
+	}
+
+	// Update the state and add the result
+	diags.Append(resp.State.Set(ctx, &config))
+
+}
@@ -0,0 +1,634 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/common"
+	"terraform-provider-idmc/internal/idmc/v2"
+	"terraform-provider-idmc/internal/idmc/v3"
+	"terraform-provider-idmc/internal/utils"
+
+	. "github.com/hashicorp/terraform-plugin-framework/resource"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+const (
+	AgentServiceRunning = "running"
+	AgentServiceStopped = "stopped"
+)
+
+// agentServiceTimeout is how long services get to reach the requested state.
+const agentServiceTimeout = 5 * time.Minute
+
+var _ ResourceWithConfigure = &AgentConfigResource{}
+var _ ResourceWithConfigValidators = &AgentConfigResource{}
+
+type AgentConfigResource struct {
+	*IdmcProviderResource
+}
+
+func NewAgentConfigResource() Resource {
+	return &AgentConfigResource{
+		&IdmcProviderResource{},
+	}
+}
+
+type AgentConfigResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	AgentId              types.String `tfsdk:"agent_id"`
+	RuntimeEnvironmentId types.String `tfsdk:"runtime_environment_id"`
+	Properties           types.Set    `tfsdk:"properties"`
+	Services             types.Map    `tfsdk:"services"`
+}
+
+type AgentConfigPropertyModel struct {
+	Type     types.String `tfsdk:"type"`
+	Subtype  types.String `tfsdk:"subtype"`
+	Name     types.String `tfsdk:"name"`
+	Value    types.String `tfsdk:"value"`
+	Platform types.String `tfsdk:"platform"`
+}
+
+var agentConfigPropertyType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":     types.StringType,
+		"subtype":  types.StringType,
+		"name":     types.StringType,
+		"value":    types.StringType,
+		"platform": types.StringType,
+	},
+}
+
+// Metadata <editor-fold desc="Metadata" defaultstate="collapsed">
+func (r AgentConfigResource) Metadata(ctx context.Context, req MetadataRequest, resp *MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_config"
+}
+
+// </editor-fold>
+
+// Schema <editor-fold desc="Schema" defaultstate="collapsed">
+func (r AgentConfigResource) Schema(ctx context.Context, req SchemaRequest, resp *SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Configuration properties of either a single Secure Agent, or all those in a runtime environment. " +
+			"Only the properties declared are managed, and they're reset to their defaults when removed. " +
+			"https://docs.informatica.com/integration-cloud/data-integration/current-version/rest-api-reference/platform-rest-api-version-2-resources/agent.html",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the agent or runtime environment configured.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"agent_id": schema.StringAttribute{
+				Description: "ID of the Secure Agent to configure.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"runtime_environment_id": schema.StringAttribute{
+				Description: "ID of the runtime environment to configure all the agents of.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"properties": agentConfigPropertiesAttribute("The configuration properties to manage."),
+			"services": schema.MapAttribute{
+				Description: fmt.Sprintf(
+					"Whether each named service on the agent should be '%s' or '%s'. "+
+						"Only available when configuring a single agent.",
+					AgentServiceRunning, AgentServiceStopped),
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf(
+						AgentServiceRunning,
+						AgentServiceStopped,
+					)),
+				},
+			},
+		},
+	}
+}
+
+// agentConfigPropertiesAttribute describes a set of agent configuration
+// properties, as managed by both agents and runtime environments.
+func agentConfigPropertiesAttribute(description string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Description: description,
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Description: "Service the property applies to, such as 'Data Integration Server'.",
+					Required:    true,
+				},
+				"subtype": schema.StringAttribute{
+					Description: "Category of the property within the service, such as 'DTM' or 'INFO'.",
+					Optional:    true,
+				},
+				"name": schema.StringAttribute{
+					Description: "Name of the property.",
+					Required:    true,
+				},
+				"value": schema.StringAttribute{
+					Description: "Value of the property.",
+					Required:    true,
+				},
+				"platform": schema.StringAttribute{
+					Description: "Platform the property applies to, if it's platform-specific.",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func (r AgentConfigResource) ConfigValidators(_ context.Context) []ConfigValidator {
+	return []ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("agent_id"),
+			path.MatchRoot("runtime_environment_id"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("runtime_environment_id"),
+			path.MatchRoot("services"),
+		),
+	}
+}
+
+// </editor-fold>
+
+// Create <editor-fold desc="Create" defaultstate="collapsed">
+func (r AgentConfigResource) Create(ctx context.Context, req CreateRequest, resp *CreateResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadCreate)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	// Load configuration from plan.
+	var plan AgentConfigResourceModel
+	if diags.Append(req.Plan.Get(ctx, &plan)) {
+		return
+	}
+
+	if r.apply(ctx, diags, &plan, nil) {
+		return
+	}
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &plan))
+
+}
+
+// </editor-fold>
+
+// Read <editor-fold desc="Read" defaultstate="collapsed">
+func (r AgentConfigResource) Read(ctx context.Context, req ReadRequest, resp *ReadResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadRead)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load the previous state.
+	var data AgentConfigResourceModel
+	if diags.Append(req.State.Get(ctx, &data)) {
+		return
+	}
+
+	configs, err := data.readConfigs(ctx, client)
+	if errors.Is(err, common.ErrNotFound) {
+		RemoveMissingResource(ctx, diags, &resp.State, data.kind(), data.Id.ValueString())
+		return
+	}
+	if diags.HandleError(err) {
+		return
+	}
+
+	if data.updatePropertiesState(ctx, diags, configs) {
+		return
+	}
+
+	if !data.Services.IsNull() {
+		statuses, err := readAgentServiceStatuses(ctx, client, data.AgentId.ValueString())
+		if diags.HandleError(err) {
+			return
+		}
+		data.updateServicesState(diags, statuses)
+	}
+
+	// Save updated data into Terraform state.
+	diags.Append(resp.State.Set(ctx, &data))
+
+}
+
+// </editor-fold>
+
+// Update <editor-fold desc="Update" defaultstate="collapsed">
+func (r AgentConfigResource) Update(ctx context.Context, req UpdateRequest, resp *UpdateResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadUpdate)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	// Load configuration from plan, and what it was from state.
+	var plan, state AgentConfigResourceModel
+	if diags.Append(req.Plan.Get(ctx, &plan)) || diags.Append(req.State.Get(ctx, &state)) {
+		return
+	}
+
+	if r.apply(ctx, diags, &plan, &state) {
+		return
+	}
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &plan))
+
+}
+
+// </editor-fold>
+
+// Delete <editor-fold desc="Delete" defaultstate="collapsed">
+func (r AgentConfigResource) Delete(ctx context.Context, req DeleteRequest, resp *DeleteResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadDelete)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load the previous state.
+	var data AgentConfigResourceModel
+	if diags.Append(req.State.Get(ctx, &data)) {
+		return
+	}
+
+	// Put the managed properties back to their defaults. Services are left in
+	// whatever state they're in.
+	properties := data.properties(ctx, diags)
+	if diags.HasError() || len(properties) == 0 {
+		return
+	}
+	resets := make([]v2.AgentConfig, len(properties))
+	for index, property := range properties {
+		resets[index] = property.reset()
+	}
+
+	_, err := data.writeConfigs(ctx, client, resets)
+	if !errors.Is(err, common.ErrNotFound) {
+		diags.HandleError(err)
+	}
+
+}
+
+// </editor-fold>
+
+// apply updates the properties that have changed since the previous state, if
+// any, then brings the services into line.
+func (r AgentConfigResource) apply(ctx context.Context, diags DiagsHandler, plan *AgentConfigResourceModel, state *AgentConfigResourceModel) bool {
+
+	clientV2 := r.GetApiClientV2(diags)
+	if diags.HasError() {
+		return true
+	}
+
+	plan.Id = plan.AgentId
+	if plan.Id.IsNull() {
+		plan.Id = plan.RuntimeEnvironmentId
+	}
+
+	planned := plan.properties(ctx, diags)
+	if diags.HasError() {
+		return true
+	}
+
+	var previous []AgentConfigPropertyModel
+	if state != nil {
+		previous = state.properties(ctx, diags)
+	}
+	updates := agentConfigUpdates(planned, previous)
+
+	if len(updates) > 0 {
+		configs, err := plan.writeConfigs(ctx, clientV2, updates)
+		if diags.HandleError(err) {
+			return true
+		}
+		if plan.updatePropertiesState(ctx, diags, configs) {
+			return true
+		}
+	}
+
+	if plan.Services.IsNull() {
+		return diags.HasError()
+	}
+
+	clientV3 := r.GetApiClientV3(diags)
+	if diags.HasError() {
+		return true
+	}
+
+	agentId := plan.AgentId.ValueString()
+	servicesDiags := diags.AtName("services")
+	for service, value := range plan.Services.Elements() {
+		wanted, ok := value.(types.String)
+		if !ok || wanted.IsNull() || wanted.IsUnknown() {
+			continue
+		}
+		serviceDiags := servicesDiags.AtMapKey(service)
+		serviceDiags.HandleError(setAgentServiceState(ctx, clientV2, clientV3, agentId, service, wanted.ValueString()))
+	}
+	if diags.HasError() {
+		return true
+	}
+
+	statuses, err := readAgentServiceStatuses(ctx, clientV2, agentId)
+	if diags.HandleError(err) {
+		return true
+	}
+	plan.updateServicesState(diags, statuses)
+
+	return diags.HasError()
+}
+
+func (m *AgentConfigResourceModel) kind() string {
+	if m.AgentId.IsNull() {
+		return "runtime environment"
+	}
+	return "agent"
+}
+
+func (m *AgentConfigResourceModel) properties(ctx context.Context, diags DiagsHandler) []AgentConfigPropertyModel {
+	return agentConfigProperties(ctx, diags.AtName("properties"), m.Properties)
+}
+
+func (m *AgentConfigResourceModel) readConfigs(ctx context.Context, client *v2.ClientWithResponses) ([]v2.AgentConfig, error) {
+	if m.AgentId.IsNull() {
+		return readRuntimeEnvironmentConfigs(ctx, client, m.RuntimeEnvironmentId.ValueString())
+	}
+
+	apiRes, apiErr := client.GetAgentWithResponse(ctx, m.AgentId.ValueString())
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if resErr := apiRes.RequireStatus(200); resErr != nil {
+		return nil, resErr
+	}
+	if apiRes.JSON200 == nil {
+		return nil, fmt.Errorf("no agent response data provided")
+	}
+	return utils.ValOr(apiRes.JSON200.AgentConfigs, nil), nil
+}
+
+func (m *AgentConfigResourceModel) writeConfigs(ctx context.Context, client *v2.ClientWithResponses, configs []v2.AgentConfig) ([]v2.AgentConfig, error) {
+	if m.AgentId.IsNull() {
+		return writeRuntimeEnvironmentConfigs(ctx, client, m.RuntimeEnvironmentId.ValueString(), configs)
+	}
+
+	apiRes, apiErr := client.UpdateAgentWithResponse(ctx, m.AgentId.ValueString(), v2.UpdateAgentRequestBody{
+		Type:         utils.Ptr(v2.UpdateAgentRequestBodyTypeAgent),
+		AgentConfigs: configs,
+	})
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if resErr := apiRes.RequireStatus(200); resErr != nil {
+		return nil, resErr
+	}
+	if apiRes.JSON200 == nil {
+		return nil, fmt.Errorf("no agent response data provided")
+	}
+	return utils.ValOr(apiRes.JSON200.AgentConfigs, nil), nil
+}
+
+// updatePropertiesState refreshes the values of the managed properties, and
+// drops any that are no longer customized.
+func (m *AgentConfigResourceModel) updatePropertiesState(ctx context.Context, diags DiagsHandler, configs []v2.AgentConfig) bool {
+	if m.Properties.IsNull() {
+		return false
+	}
+
+	m.Properties = agentConfigPropertiesValue(diags.AtName("properties"), m.properties(ctx, diags), configs)
+
+	return diags.HasError()
+}
+
+// updateServicesState records the status of each managed service, dropping
+// those the agent no longer has.
+func (m *AgentConfigResourceModel) updateServicesState(diags DiagsHandler, statuses map[string]string) {
+	services := map[string]attr.Value{}
+	for service := range m.Services.Elements() {
+		if status, ok := statuses[service]; ok {
+			services[service] = types.StringValue(status)
+		}
+	}
+	m.Services = diags.AtName("services").MapValue(types.StringType, services)
+}
+
+func (p AgentConfigPropertyModel) key() string {
+	return agentConfigKey(p.Type.ValueString(), p.Subtype.ValueStringPointer(), p.Name.ValueString(), p.Platform.ValueStringPointer())
+}
+
+func (p AgentConfigPropertyModel) customize() v2.AgentConfig {
+	return v2.AgentConfig{
+		Type:       p.Type.ValueString(),
+		Subtype:    p.Subtype.ValueStringPointer(),
+		Name:       p.Name.ValueString(),
+		Value:      p.Value.ValueStringPointer(),
+		Platform:   p.Platform.ValueStringPointer(),
+		Customized: utils.Ptr(true),
+	}
+}
+
+func (p AgentConfigPropertyModel) reset() v2.AgentConfig {
+	return v2.AgentConfig{
+		Type:       p.Type.ValueString(),
+		Subtype:    p.Subtype.ValueStringPointer(),
+		Name:       p.Name.ValueString(),
+		Platform:   p.Platform.ValueStringPointer(),
+		Customized: utils.Ptr(false),
+	}
+}
+
+func agentConfigProperties(ctx context.Context, diags DiagsHandler, value types.Set) []AgentConfigPropertyModel {
+	var properties []AgentConfigPropertyModel
+	if !value.IsNull() && !value.IsUnknown() {
+		diags.Append(value.ElementsAs(ctx, &properties, false))
+	}
+	return properties
+}
+
+// agentConfigUpdates works out the changes needed to go from the previously
+// managed properties to those planned. Only new or changed values are sent,
+// and anything no longer managed is reset, as nobody else is looking after it.
+func agentConfigUpdates(planned []AgentConfigPropertyModel, previous []AgentConfigPropertyModel) []v2.AgentConfig {
+	previousValues := map[string]types.String{}
+	for _, property := range previous {
+		previousValues[property.key()] = property.Value
+	}
+
+	var updates []v2.AgentConfig
+	plannedKeys := map[string]bool{}
+	for _, property := range planned {
+		plannedKeys[property.key()] = true
+		if value, ok := previousValues[property.key()]; ok && value.Equal(property.Value) {
+			continue
+		}
+		updates = append(updates, property.customize())
+	}
+	for _, property := range previous {
+		if !plannedKeys[property.key()] {
+			updates = append(updates, property.reset())
+		}
+	}
+	return updates
+}
+
+// agentConfigPropertiesValue refreshes the values of the managed properties,
+// and drops any that are no longer customized.
+func agentConfigPropertiesValue(diags DiagsHandler, managed []AgentConfigPropertyModel, configs []v2.AgentConfig) types.Set {
+	current := map[string]v2.AgentConfig{}
+	for _, config := range configs {
+		current[agentConfigKey(config.Type, config.Subtype, config.Name, config.Platform)] = config
+	}
+
+	var properties []attr.Value
+	for _, property := range managed {
+		config, ok := current[property.key()]
+		if !ok || !utils.Val(config.Customized) {
+			continue
+		}
+		properties = append(properties, diags.ObjectValue(agentConfigPropertyType.AttrTypes, map[string]attr.Value{
+			"type":     property.Type,
+			"subtype":  property.Subtype,
+			"name":     property.Name,
+			"value":    types.StringPointerValue(config.Value),
+			"platform": property.Platform,
+		}))
+	}
+	return diags.SetValue(agentConfigPropertyType, properties)
+}
+
+// agentConfigKey identifies a property regardless of its value.
+func agentConfigKey(configType string, subtype *string, name string, platform *string) string {
+	return strings.Join([]string{configType, utils.Val(subtype), name, utils.Val(platform)}, "\x00")
+}
+
+func readRuntimeEnvironmentConfigs(ctx context.Context, client *v2.ClientWithResponses, id string) ([]v2.AgentConfig, error) {
+	apiRes, apiErr := client.GetRuntimeEnvironmentConfigsWithResponse(ctx, id)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if resErr := apiRes.RequireStatus(200); resErr != nil {
+		return nil, resErr
+	}
+	if apiRes.JSON200 == nil {
+		return nil, fmt.Errorf("no runtime environment configuration response data provided")
+	}
+	return apiRes.JSON200.AgentConfigs, nil
+}
+
+func writeRuntimeEnvironmentConfigs(ctx context.Context, client *v2.ClientWithResponses, id string, configs []v2.AgentConfig) ([]v2.AgentConfig, error) {
+	apiRes, apiErr := client.UpdateRuntimeEnvironmentConfigsWithResponse(ctx, id, v2.AgentConfigList{
+		AgentConfigs: configs,
+	})
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if resErr := apiRes.RequireStatus(200); resErr != nil {
+		return nil, resErr
+	}
+	if apiRes.JSON200 == nil {
+		return nil, fmt.Errorf("no runtime environment configuration response data provided")
+	}
+	return apiRes.JSON200.AgentConfigs, nil
+}
+
+// readAgentServiceStatuses returns the status of each service on an agent,
+// normalised to lowercase.
+func readAgentServiceStatuses(ctx context.Context, client *v2.ClientWithResponses, agentId string) (map[string]string, error) {
+	apiRes, apiErr := client.GetAgentDetailsWithResponse(ctx, agentId)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if resErr := apiRes.RequireStatus(200); resErr != nil {
+		return nil, resErr
+	}
+	if apiRes.JSON200 == nil {
+		return nil, fmt.Errorf("no agent details response data provided")
+	}
+
+	statuses := map[string]string{}
+	for _, engine := range utils.ValOr(apiRes.JSON200.AgentEngines, nil) {
+		if status := engine.AgentEngineStatus; status != nil && status.Appname != nil {
+			statuses[*status.Appname] = strings.ToLower(utils.Val(status.Status))
+		}
+	}
+	return statuses, nil
+}
+
+// setAgentServiceState starts or stops a service if needed, and waits until
+// it gets there.
+func setAgentServiceState(
+	ctx context.Context,
+	clientV2 *v2.ClientWithResponses,
+	clientV3 *v3.ClientWithResponses,
+	agentId string,
+	service string,
+	wanted string,
+) error {
+
+	statuses, err := readAgentServiceStatuses(ctx, clientV2, agentId)
+	if err != nil {
+		return err
+	}
+	status, ok := statuses[service]
+	if !ok {
+		return fmt.Errorf("the agent has no service named %q", service)
+	}
+	if status == wanted {
+		return nil
+	}
+
+	action := v3.AgentServiceRequestBodyServiceActionStart
+	if wanted == AgentServiceStopped {
+		action = v3.AgentServiceRequestBodyServiceActionStop
+	}
+	apiRes, apiErr := clientV3.ControlAgentServiceWithResponse(ctx, &v3.ControlAgentServiceParams{}, v3.AgentServiceRequestBody{
+		AgentId:       agentId,
+		ServiceName:   service,
+		ServiceAction: action,
+	})
+	if apiErr != nil {
+		return apiErr
+	}
+	if resErr := apiRes.RequireStatus(200); resErr != nil {
+		return resErr
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, agentServiceTimeout)
+	defer cancel()
+
+	return common.Poll(waitCtx, common.DefaultPollInterval, func(ctx context.Context) (bool, error) {
+		statuses, err := readAgentServiceStatuses(ctx, clientV2, agentId)
+		if err != nil {
+			return false, err
+		}
+		return statuses[service] == wanted, nil
+	})
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/v2"
+	"terraform-provider-idmc/internal/utils"
+
+	. "github.com/onsi/gomega"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+func testAgentConfigProperty(subtype string, name string, value string) AgentConfigPropertyModel {
+	return AgentConfigPropertyModel{
+		Type:     types.StringValue("Data Integration Server"),
+		Subtype:  types.StringValue(subtype),
+		Name:     types.StringValue(name),
+		Value:    types.StringValue(value),
+		Platform: types.StringNull(),
+	}
+}
+
+func TestAgentConfigKey(t *testing.T) {
+	RegisterTestingT(t)
+
+	property := testAgentConfigProperty("DTM", "JVMOption1", "-Xmx1024m")
+
+	// Matches the api's version of the property, whatever its value.
+	Expect(property.key()).To(Equal(agentConfigKey("Data Integration Server", utils.Ptr("DTM"), "JVMOption1", nil)))
+
+	// But not one that only differs in its subtype or platform.
+	Expect(property.key()).NotTo(Equal(agentConfigKey("Data Integration Server", utils.Ptr("INFO"), "JVMOption1", nil)))
+	Expect(property.key()).NotTo(Equal(agentConfigKey("Data Integration Server", utils.Ptr("DTM"), "JVMOption1", utils.Ptr("linux64"))))
+
+}
+
+func TestAgentConfigUpdates(t *testing.T) {
+	RegisterTestingT(t)
+
+	unchanged := testAgentConfigProperty("DTM", "JVMOption1", "-Xmx1024m")
+	changed := testAgentConfigProperty("DTM", "JVMOption2", "-Xms512m")
+	added := testAgentConfigProperty("DTM", "JVMOption3", "-Dfoo=bar")
+	removed := testAgentConfigProperty("INFO", "LogLevel", "DEBUG")
+
+	updates := agentConfigUpdates(
+		[]AgentConfigPropertyModel{unchanged, changed, added},
+		[]AgentConfigPropertyModel{unchanged, testAgentConfigProperty("DTM", "JVMOption2", "-Xms256m"), removed},
+	)
+
+	// Unchanged properties aren't sent again, and removed ones are reset.
+	Expect(updates).To(ConsistOf(
+		changed.customize(),
+		added.customize(),
+		removed.reset(),
+	))
+	Expect(removed.reset().Value).To(BeNil())
+	Expect(utils.Val(removed.reset().Customized)).To(BeFalse())
+	Expect(utils.Val(added.customize().Customized)).To(BeTrue())
+
+	// Nothing to do when nothing has changed.
+	Expect(agentConfigUpdates(
+		[]AgentConfigPropertyModel{unchanged},
+		[]AgentConfigPropertyModel{unchanged},
+	)).To(BeEmpty())
+
+}
+
+func TestAgentConfigPropertiesValue(t *testing.T) {
+	RegisterTestingT(t)
+
+	customized := testAgentConfigProperty("DTM", "JVMOption1", "-Xmx1024m")
+	reverted := testAgentConfigProperty("DTM", "JVMOption2", "-Xms512m")
+	missing := testAgentConfigProperty("DTM", "JVMOption3", "-Dfoo=bar")
+
+	var diagnostics diag.Diagnostics
+	value := agentConfigPropertiesValue(
+		NewDiagsHandler(&diagnostics, MsgResourceBadRead),
+		[]AgentConfigPropertyModel{customized, reverted, missing},
+		[]v2.AgentConfig{
+			{Type: "Data Integration Server", Subtype: utils.Ptr("DTM"), Name: "JVMOption1", Value: utils.Ptr("-Xmx2048m"), Customized: utils.Ptr(true)},
+			{Type: "Data Integration Server", Subtype: utils.Ptr("DTM"), Name: "JVMOption2", Value: utils.Ptr("-Xms512m"), Customized: utils.Ptr(false)},
+			{Type: "Data Integration Server", Subtype: utils.Ptr("DTM"), Name: "Unmanaged", Value: utils.Ptr("x"), Customized: utils.Ptr(true)},
+		},
+	)
+	Expect(diagnostics).To(BeEmpty())
+
+	// Only the still customized property is kept, with its current value.
+	Expect(value.Elements()).To(HaveLen(1))
+	var properties []AgentConfigPropertyModel
+	Expect(value.ElementsAs(context.Background(), &properties, false)).To(BeEmpty())
+	Expect(properties[0].Name.ValueString()).To(Equal("JVMOption1"))
+	Expect(properties[0].Value.ValueString()).To(Equal("-Xmx2048m"))
+
+}
@@ -44,8 +44,9 @@ func (d *AgentInstallerDataSource) Schema(_ context.Context, _ SchemaRequest, re
 				Computed:    true,
 			},
 			"install_token": schema.StringAttribute{
-				Description: "Token needed to install and register a Secure Agent.",
+				Description: "Token needed to install and register a Secure Agent. Use the idmc_agent_installer ephemeral resource instead to keep it out of state.",
 				Computed:    true,
+				Sensitive:   true,
 			},
 			"checksum_download_url": schema.StringAttribute{
 				Description: "The URL of the CRC-32 SHA256 package checksum.",
@@ -77,19 +78,7 @@ func (d *AgentInstallerDataSource) Read(ctx context.Context, req ReadRequest, re
 	}
 
 	// Handle error responses.
-	if apiRes.StatusCode() != 200 {
-		CheckApiErrorV2(diags,
-			apiRes.JSON400,
-			apiRes.JSON401,
-			apiRes.JSON403,
-			apiRes.JSON404,
-			apiRes.JSON500,
-			apiRes.JSON502,
-			apiRes.JSON503,
-		)
-		if !diags.HasError() {
-			diags.HandleError(RequireHttpStatus(&apiRes.ClientResponse, 200))
-		}
+	if diags.HandleError(apiRes.RequireStatus(200)) {
 		return
 	}
 
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	. "github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+var _ EphemeralResourceWithConfigure = &AgentInstallerEphemeralResource{}
+
+type AgentInstallerEphemeralResource struct {
+	*IdmcProviderEphemeralResource
+}
+
+func NewAgentInstallerEphemeralResource() EphemeralResource {
+	return &AgentInstallerEphemeralResource{
+		&IdmcProviderEphemeralResource{},
+	}
+}
+
+func (r *AgentInstallerEphemeralResource) Metadata(_ context.Context, req MetadataRequest, resp *MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_installer"
+}
+
+func (r *AgentInstallerEphemeralResource) Schema(_ context.Context, _ SchemaRequest, resp *SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "https://docs.informatica.com/integration-cloud/b2b-gateway/current-version/rest-api-reference/platform-rest-api-version-2-resources/agent.html",
+		Attributes: map[string]schema.Attribute{
+			"platform": schema.StringAttribute{
+				Description: "Platform of the Secure Agent machine. Must be one of the following values:\nwin64\nlinux64",
+				Optional:    true,
+			},
+			"download_url": schema.StringAttribute{
+				Description: "The URL of the latest Secure Agent installer package.",
+				Computed:    true,
+			},
+			"install_token": schema.StringAttribute{
+				Description: "Token needed to install and register a Secure Agent.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"checksum_download_url": schema.StringAttribute{
+				Description: "The URL of the CRC-32 SHA256 package checksum.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *AgentInstallerEphemeralResource) Open(ctx context.Context, req OpenRequest, resp *OpenResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgEphemeralResourceBadOpen)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	var config AgentInstallerDataSourceModel
+	if diags.Append(req.Config.Get(ctx, &config)) {
+		return
+	}
+
+	// Perform the API request.
+	apiRes, apiErr := client.GetAgentInstallerInfoWithResponse(ctx, config.Platform.ValueString())
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Handle error responses.
+	if diags.HandleError(apiRes.RequireStatus(200)) {
+		return
+	}
+
+	// Convert response data into terraform types.
+	config.DownloadUrl = types.StringPointerValue(apiRes.JSON200.DownloadUrl)
+	config.InstallToken = types.StringPointerValue(apiRes.JSON200.InstallToken)
+	config.ChecksumDownloadUrl = types.StringPointerValue(apiRes.JSON200.ChecksumDownloadUrl)
+
+	diags.Append(resp.Result.Set(ctx, &config))
+
+}
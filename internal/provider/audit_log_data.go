@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/common"
+	"terraform-provider-idmc/internal/idmc/v2"
+	"terraform-provider-idmc/internal/utils"
+
+	. "github.com/hashicorp/terraform-plugin-framework/datasource"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+var _ DataSourceWithConfigure = &AuditLogDataSource{}
+
+type AuditLogDataSource struct {
+	*IdmcProviderDataSource
+}
+
+func NewAuditLogDataSource() DataSource {
+	return &AuditLogDataSource{
+		&IdmcProviderDataSource{},
+	}
+}
+
+type AuditLogDataSourceModel struct {
+	StartTime  timetypes.RFC3339 `tfsdk:"start_time"`
+	EndTime    timetypes.RFC3339 `tfsdk:"end_time"`
+	User       types.String      `tfsdk:"user"`
+	Category   types.String      `tfsdk:"category"`
+	MaxResults types.Int64       `tfsdk:"max_results"`
+	Entries    types.List        `tfsdk:"entries"`
+}
+
+func (d *AuditLogDataSource) Metadata(_ context.Context, req MetadataRequest, resp *MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_log"
+}
+
+func (d *AuditLogDataSource) Schema(_ context.Context, _ SchemaRequest, resp *SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "https://docs.informatica.com/integration-cloud/data-integration/current-version/rest-api-reference/platform-rest-api-version-2-resources/auditlog.html",
+		Attributes: map[string]schema.Attribute{
+			"start_time": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Description: "Only include entries from this time onwards.",
+				Optional:    true,
+			},
+			"end_time": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Description: "Only include entries up to this time.",
+				Optional:    true,
+			},
+			"user": schema.StringAttribute{
+				Description: "Only include actions performed by this user.",
+				Optional:    true,
+			},
+			"category": schema.StringAttribute{
+				Description: "Only include actions on this category of object, such as 'USER' or 'ROLE'.",
+				Optional:    true,
+			},
+			"max_results": schema.Int64Attribute{
+				Description: "The maximum number of entries to return, most recent first. " +
+					"Defaults to 200 when start_time isn't set, and is otherwise unlimited.",
+				Optional: true,
+			},
+			"entries": schema.ListNestedAttribute{
+				Description: "The matching entries, most recent first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Audit log entry ID.",
+							Computed:    true,
+						},
+						"user": schema.StringAttribute{
+							Description: "User who performed the action.",
+							Computed:    true,
+						},
+						"time": schema.StringAttribute{
+							CustomType:  timetypes.RFC3339Type{},
+							Description: "When the action was performed.",
+							Computed:    true,
+						},
+						"category": schema.StringAttribute{
+							Description: "Category of the object the action was performed on.",
+							Computed:    true,
+						},
+						"event": schema.StringAttribute{
+							Description: "The action performed, such as 'CREATE', 'UPDATE', or 'DELETE'.",
+							Computed:    true,
+						},
+						"event_param": schema.StringAttribute{
+							Description: "Details of the action.",
+							Computed:    true,
+						},
+						"object_id": schema.StringAttribute{
+							Description: "ID of the object the action was performed on.",
+							Computed:    true,
+						},
+						"object_name": schema.StringAttribute{
+							Description: "Name of the object the action was performed on.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "Description of the action.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var auditLogEntryType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":          types.StringType,
+		"user":        types.StringType,
+		"time":        timetypes.RFC3339Type{},
+		"category":    types.StringType,
+		"event":       types.StringType,
+		"event_param": types.StringType,
+		"object_id":   types.StringType,
+		"object_name": types.StringType,
+		"message":     types.StringType,
+	},
+}
+
+func (d *AuditLogDataSource) Read(ctx context.Context, req ReadRequest, resp *ReadResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgDataSourceBadRead)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := d.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load the previous state if present.
+	var config AuditLogDataSourceModel
+	if diags.Append(req.Config.Get(ctx, &config)) {
+		return
+	}
+
+	filter := newLogFilter(diags, config.StartTime, config.EndTime, config.User, config.MaxResults)
+	if diags.HasError() {
+		return
+	}
+
+	// The audit log is paged by batch number rather than offset.
+	pages := common.NewPaginator(common.DefaultPageLimit, func(ctx context.Context, limit int32, skip int32) ([]v2.AuditLogEntry, error) {
+		apiRes, apiErr := client.GetAuditLogWithResponse(ctx, &v2.GetAuditLogParams{
+			BatchId:   utils.Ptr(skip / limit),
+			BatchSize: &limit,
+		})
+		if apiErr != nil {
+			return nil, apiErr
+		}
+
+		return utils.ValOr(apiRes.JSON200, nil), apiRes.RequireStatus(200)
+	})
+
+	category := config.Category.ValueString()
+	entries, entriesErr := collectLogEntries(ctx, filter, pages,
+		func(entry v2.AuditLogEntry) *time.Time { return parseLogTime(entry.EntryTime) },
+		func(entry v2.AuditLogEntry) *string { return entry.Username },
+		func(entry v2.AuditLogEntry) bool {
+			return category == "" || strings.EqualFold(utils.Val(entry.Category), category)
+		},
+	)
+	if diags.HandleError(entriesErr) {
+		return
+	}
+
+	entriesDiags := diags.AtName("entries")
+	entryAttrs := make([]attr.Value, len(entries))
+	for index, entry := range entries {
+		entryDiags := entriesDiags.AtListIndex(index)
+		entryAttrs[index] = entryDiags.ObjectValue(auditLogEntryType.AttrTypes, map[string]attr.Value{
+			"id":          types.StringPointerValue(entry.Id),
+			"user":        types.StringPointerValue(entry.Username),
+			"time":        entryDiags.AtName("time").TimePointer(entry.EntryTime),
+			"category":    types.StringPointerValue(entry.Category),
+			"event":       types.StringPointerValue(entry.Event),
+			"event_param": types.StringPointerValue(entry.EventParam),
+			"object_id":   types.StringPointerValue(entry.ObjectId),
+			"object_name": types.StringPointerValue(entry.ObjectName),
+			"message":     types.StringPointerValue(entry.Message),
+		})
+	}
+
+	config.Entries = entriesDiags.ListValue(auditLogEntryType, entryAttrs)
+	if diags.HasError() {
+		return
+	}
+
+	// Update the state and add the result
+	diags.Append(resp.State.Set(ctx, &config))
+
+}
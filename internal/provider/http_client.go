@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+// newHttpClient builds the http client used for every IDMC request (including
+// login), applying any proxy, TLS, and timeout settings from the provider
+// config or the environment.
+func newHttpClient(diags DiagsHandler, config IdmcProviderModel) *http.Client {
+	transport, ok := http.DefaultTransport.(*http.Transport)
+	if ok {
+		transport = transport.Clone()
+	} else {
+		transport = &http.Transport{Proxy: http.ProxyFromEnvironment}
+	}
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	// Only override the environment-derived proxy when explicitly configured.
+	if proxyUrl := getCfgVal(diags, config.ProxyUrl, "proxy_url", false); proxyUrl != "" {
+		parsedUrl, err := url.Parse(proxyUrl)
+		if err != nil {
+			diags.AtName("proxy_url").AddError("Unable to parse proxy url: %s", err)
+		} else {
+			transport.Proxy = http.ProxyURL(parsedUrl)
+		}
+	}
+
+	// Add any custom CAs on top of the system roots.
+	if caPem := getCfgPem(diags, config.CaCertFile, config.CaCertPem, "ca_cert"); caPem != nil {
+		caPool, err := x509.SystemCertPool()
+		if err != nil {
+			caPool = x509.NewCertPool()
+		}
+		if !caPool.AppendCertsFromPEM(caPem) {
+			diags.AtName("ca_cert_pem").AddError("No valid certificates found in the CA bundle.")
+		}
+		transport.TLSClientConfig.RootCAs = caPool
+	}
+
+	// Load the client certificate for mTLS, which needs both halves.
+	certPem := getCfgPem(diags, config.ClientCertFile, config.ClientCertPem, "client_cert")
+	keyPem := getCfgPem(diags, config.ClientKeyFile, config.ClientKeyPem, "client_key")
+	if certPem != nil && keyPem != nil {
+		clientCert, err := tls.X509KeyPair(certPem, keyPem)
+		if err != nil {
+			diags.AtName("client_cert_pem").AddError("Unable to load client certificate: %s", err)
+		} else {
+			transport.TLSClientConfig.Certificates = []tls.Certificate{clientCert}
+		}
+	} else if certPem != nil {
+		diags.AtName("client_key_pem").AddError("A client key is needed alongside the client certificate.")
+	} else if keyPem != nil {
+		diags.AtName("client_cert_pem").AddError("A client certificate is needed alongside the client key.")
+	}
+
+	if getCfgBool(diags, config.InsecureSkipVerify, "insecure_skip_verify") {
+		diags.AtName("insecure_skip_verify").WithTitle("Insecure TLS configuration").AddWarning(
+			"TLS certificate verification is DISABLED for all IDMC requests. Credentials and " +
+				"session ids can be intercepted by anyone able to tamper with the connection.")
+		transport.TLSClientConfig.InsecureSkipVerify = true
+	}
+
+	var timeout time.Duration
+	if timeoutVal := getCfgVal(diags, config.RequestTimeout, "request_timeout", false); timeoutVal != "" {
+		parsedTimeout, err := time.ParseDuration(timeoutVal)
+		if err != nil {
+			diags.AtName("request_timeout").AddError("Unable to parse request timeout: %s", err)
+		} else if parsedTimeout < 0 {
+			diags.AtName("request_timeout").AddError("Request timeout cannot be negative.")
+		} else {
+			timeout = parsedTimeout
+		}
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+	}
+}
+
+// getCfgPem resolves PEM content from either the inline '<name>_pem' value or
+// the file referenced by '<name>_file', returning nil if neither are set.
+func getCfgPem(diags DiagsHandler, fileVal types.String, pemVal types.String, name string) []byte {
+	if pem := getCfgVal(diags, pemVal, name+"_pem", false); pem != "" {
+		return []byte(pem)
+	}
+
+	filePath := getCfgVal(diags, fileVal, name+"_file", false)
+	if filePath == "" {
+		return nil
+	}
+
+	pem, err := os.ReadFile(filePath)
+	if err != nil {
+		diags.AtName(name+"_file").AddError("Unable to read file: %s", err)
+		return nil
+	}
+	return pem
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	. "github.com/onsi/gomega"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+func TestNewHttpClient(t *testing.T) {
+	RegisterTestingT(t)
+
+	var diagnostics diag.Diagnostics
+	diags := NewDiagsHandler(&diagnostics, MsgProviderBadConfigure)
+
+	httpClient := newHttpClient(diags, IdmcProviderModel{
+		ProxyUrl:           types.StringValue("http://proxy.example.com:3128"),
+		InsecureSkipVerify: types.BoolValue(true),
+		RequestTimeout:     types.StringValue("45s"),
+	})
+
+	Expect(diagnostics.HasError()).To(BeFalse())
+	Expect(diagnostics.WarningsCount()).To(Equal(1))
+	Expect(httpClient.Timeout).To(Equal(45 * time.Second))
+
+	transport, ok := httpClient.Transport.(*http.Transport)
+	Expect(ok).To(BeTrue())
+	Expect(transport.TLSClientConfig.InsecureSkipVerify).To(BeTrue())
+
+	proxyUrl, proxyErr := transport.Proxy(&http.Request{})
+	Expect(proxyErr).To(BeNil())
+	Expect(proxyUrl.Host).To(Equal("proxy.example.com:3128"))
+
+}
+
+func TestNewHttpClientBadTls(t *testing.T) {
+	RegisterTestingT(t)
+
+	var diagnostics diag.Diagnostics
+	diags := NewDiagsHandler(&diagnostics, MsgProviderBadConfigure)
+
+	_ = newHttpClient(diags, IdmcProviderModel{
+		CaCertPem:     types.StringValue("not a certificate"),
+		ClientCertPem: types.StringValue("also not a certificate"),
+	})
+
+	// One for the CA bundle, and one for the missing client key.
+	Expect(diagnostics.ErrorsCount()).To(Equal(2))
+
+}
@@ -0,0 +1,430 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/common"
+	"terraform-provider-idmc/internal/idmc/v2"
+	"terraform-provider-idmc/internal/utils"
+
+	. "github.com/hashicorp/terraform-plugin-framework/resource"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+var _ ResourceWithConfigure = &JobRunResource{}
+
+const (
+	JobRunStatusRunning = "RUNNING"
+	JobRunStatusSuccess = "SUCCESS"
+	JobRunStatusWarning = "WARNING"
+	JobRunStatusFailed  = "FAILED"
+
+	jobRunDefaultPollInterval = 10 * time.Second
+	jobRunDefaultTimeout      = 30 * time.Minute
+)
+
+// jobRunStatuses maps the activity log entry states to job run statuses.
+var jobRunStatuses = map[int]string{
+	1: JobRunStatusSuccess,
+	2: JobRunStatusWarning,
+	3: JobRunStatusFailed,
+}
+
+type JobRunResource struct {
+	*IdmcProviderResource
+}
+
+func NewJobRunResource() Resource {
+	return &JobRunResource{
+		&IdmcProviderResource{},
+	}
+}
+
+type JobRunResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	TaskId            types.String `tfsdk:"task_id"`
+	TaskType          types.String `tfsdk:"task_type"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+	Wait              types.Bool   `tfsdk:"wait"`
+	PollInterval      types.String `tfsdk:"poll_interval"`
+	Timeout           types.String `tfsdk:"timeout"`
+	RunId             types.Int64  `tfsdk:"run_id"`
+	TaskName          types.String `tfsdk:"task_name"`
+	Status            types.String `tfsdk:"status"`
+	StartTime         types.String `tfsdk:"start_time"`
+	EndTime           types.String `tfsdk:"end_time"`
+	SuccessSourceRows types.Int64  `tfsdk:"success_source_rows"`
+	FailedSourceRows  types.Int64  `tfsdk:"failed_source_rows"`
+	SuccessTargetRows types.Int64  `tfsdk:"success_target_rows"`
+	FailedTargetRows  types.Int64  `tfsdk:"failed_target_rows"`
+	ErrorMessage      types.String `tfsdk:"error_message"`
+}
+
+// Metadata <editor-fold desc="Metadata" defaultstate="collapsed">
+func (r JobRunResource) Metadata(ctx context.Context, req MetadataRequest, resp *MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_run"
+}
+
+// </editor-fold>
+
+// Schema <editor-fold desc="Schema" defaultstate="collapsed">
+func (r JobRunResource) Schema(ctx context.Context, req SchemaRequest, resp *SchemaResponse) {
+	keepState := []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+	}
+	keepStateInt := []planmodifier.Int64{
+		int64planmodifier.UseStateForUnknown(),
+	}
+	resp.Schema = schema.Schema{
+		Description: "Runs a task or taskflow once, such as a smoke test after a deployment, failing if the run fails. " +
+			"The task is run again whenever the task or any of the triggers change. Destroying the resource doesn't " +
+			"affect the run at all. " +
+			"https://docs.informatica.com/integration-cloud/data-integration/current-version/rest-api-reference/platform-rest-api-version-2-resources/job.html",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "Same as the run id.",
+				Computed:      true,
+				PlanModifiers: keepState,
+			},
+			"task_id": schema.StringAttribute{
+				Description: "ID of the task to run, or the federated id of a taskflow.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"task_type": schema.StringAttribute{
+				Description: "The type of task: DMASK, DRS, DSS, MTT, PCS, WORKFLOW (linear taskflow), or TASKFLOW.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(v2.TaskTypeDMASK),
+						string(v2.TaskTypeDRS),
+						string(v2.TaskTypeDSS),
+						string(v2.TaskTypeMTT),
+						string(v2.TaskTypePCS),
+						string(v2.TaskTypeWORKFLOW),
+						string(v2.TaskTypeTASKFLOW),
+					),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that cause the task to be run again whenever they change.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait": schema.BoolAttribute{
+				Description: "Whether to wait for the run to finish, failing if it does. Defaults to true.",
+				Optional:    true,
+			},
+			"poll_interval": schema.StringAttribute{
+				Description: "How often to check whether the run has finished, such as '30s'. Defaults to 10s.",
+				Optional:    true,
+			},
+			"timeout": schema.StringAttribute{
+				Description: "How long to wait for the run to finish, such as '1h'. Defaults to 30m.",
+				Optional:    true,
+			},
+			"run_id": schema.Int64Attribute{
+				Description:   "ID of the run, for finding it in the activity log.",
+				Computed:      true,
+				PlanModifiers: keepStateInt,
+			},
+			"task_name": schema.StringAttribute{
+				Description:   "Name of the task that was run.",
+				Computed:      true,
+				PlanModifiers: keepState,
+			},
+			"status": schema.StringAttribute{
+				Description:   "Status of the run: RUNNING, SUCCESS, WARNING, or FAILED.",
+				Computed:      true,
+				PlanModifiers: keepState,
+			},
+			"start_time": schema.StringAttribute{
+				Description:   "When the run started.",
+				Computed:      true,
+				PlanModifiers: keepState,
+			},
+			"end_time": schema.StringAttribute{
+				Description:   "When the run finished.",
+				Computed:      true,
+				PlanModifiers: keepState,
+			},
+			"success_source_rows": schema.Int64Attribute{
+				Description:   "Number of rows successfully read from the source.",
+				Computed:      true,
+				PlanModifiers: keepStateInt,
+			},
+			"failed_source_rows": schema.Int64Attribute{
+				Description:   "Number of rows that couldn't be read from the source.",
+				Computed:      true,
+				PlanModifiers: keepStateInt,
+			},
+			"success_target_rows": schema.Int64Attribute{
+				Description:   "Number of rows successfully written to the target.",
+				Computed:      true,
+				PlanModifiers: keepStateInt,
+			},
+			"failed_target_rows": schema.Int64Attribute{
+				Description:   "Number of rows that couldn't be written to the target.",
+				Computed:      true,
+				PlanModifiers: keepStateInt,
+			},
+			"error_message": schema.StringAttribute{
+				Description:   "Why the run failed, if it did.",
+				Computed:      true,
+				PlanModifiers: keepState,
+			},
+		},
+	}
+}
+
+// </editor-fold>
+
+// Create <editor-fold desc="Create" defaultstate="collapsed">
+func (r JobRunResource) Create(ctx context.Context, req CreateRequest, resp *CreateResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadCreate)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load configuration from plan.
+	var data JobRunResourceModel
+	if diags.Append(req.Plan.Get(ctx, &data)) {
+		return
+	}
+
+	pollInterval := parseJobRunDuration(diags.AtName("poll_interval"), data.PollInterval, jobRunDefaultPollInterval)
+	timeout := parseJobRunDuration(diags.AtName("timeout"), data.Timeout, jobRunDefaultTimeout)
+	if diags.HasError() {
+		return
+	}
+
+	// Taskflows can only be referenced by their federated id.
+	taskType := v2.TaskType(data.TaskType.ValueString())
+	reqBody := v2.StartJobJSONRequestBody{
+		Type:     utils.Ptr(v2.JobRequestBodyTypeJob),
+		TaskType: taskType,
+	}
+	if taskType == v2.TaskTypeTASKFLOW {
+		reqBody.TaskFederatedId = data.TaskId.ValueStringPointer()
+	} else {
+		reqBody.TaskId = data.TaskId.ValueStringPointer()
+	}
+
+	apiRes, apiErr := client.StartJobWithResponse(ctx, reqBody)
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Handle error responses.
+	if diags.HandleError(apiRes.RequireStatus(200)) {
+		return
+	}
+	if apiRes.JSON200 == nil || apiRes.JSON200.RunId == nil {
+		diags.AddError("no job run id in the response")
+		return
+	}
+
+	runId := *apiRes.JSON200.RunId
+	data.Id = types.StringValue(strconv.FormatInt(runId, 10))
+	data.RunId = types.Int64Value(runId)
+	data.TaskName = types.StringPointerValue(apiRes.JSON200.TaskName)
+	r.updateJobRunState(&data, nil)
+
+	// Save the run straight away so it's tracked even if waiting fails.
+	if diags.Append(resp.State.Set(ctx, &data)) {
+		return
+	}
+
+	if !data.Wait.IsNull() && !data.Wait.ValueBool() {
+		return
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	pollErr := common.Poll(waitCtx, pollInterval, func(ctx context.Context) (bool, error) {
+		entry, err := r.getActivityLogEntry(ctx, client, data.TaskId.ValueString(), runId)
+		if err != nil {
+			return false, err
+		}
+		r.updateJobRunState(&data, entry)
+		return entry != nil, nil
+	})
+	if diags.HandleError(pollErr) {
+		return
+	}
+
+	if data.Status.ValueString() == JobRunStatusFailed {
+		diags.AddError("Job run %d of task '%s' failed: %s",
+			runId, data.TaskId.ValueString(), data.ErrorMessage.ValueString())
+	}
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &data))
+
+}
+
+// </editor-fold>
+
+// Read <editor-fold desc="Read" defaultstate="collapsed">
+func (r JobRunResource) Read(ctx context.Context, req ReadRequest, resp *ReadResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadRead)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	// Load the previous state.
+	var data JobRunResourceModel
+	if diags.Append(req.State.Get(ctx, &data)) {
+		return
+	}
+
+	// Finished runs never change, so there's nothing more to read.
+	if data.Status.ValueString() != JobRunStatusRunning {
+		return
+	}
+
+	client := r.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	entry, entryErr := r.getActivityLogEntry(ctx, client, data.TaskId.ValueString(), data.RunId.ValueInt64())
+	if diags.HandleError(entryErr) {
+		return
+	}
+	r.updateJobRunState(&data, entry)
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &data))
+
+}
+
+// </editor-fold>
+
+// Update <editor-fold desc="Update" defaultstate="collapsed">
+func (r JobRunResource) Update(ctx context.Context, req UpdateRequest, resp *UpdateResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadUpdate)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	// Only the waiting behaviour can change without a new run, and that has
+	// no effect on a run that has already started.
+	var plan JobRunResourceModel
+	if diags.Append(req.Plan.Get(ctx, &plan)) {
+		return
+	}
+
+	diags.Append(resp.State.Set(ctx, &plan))
+
+}
+
+// </editor-fold>
+
+// Delete <editor-fold desc="Delete" defaultstate="collapsed">
+func (r JobRunResource) Delete(ctx context.Context, req DeleteRequest, resp *DeleteResponse) {
+	// Runs can't be undone, so they're simply forgotten.
+}
+
+// </editor-fold>
+
+// getActivityLogEntry finds the activity log entry of a run, which is only
+// present once the run has finished.
+func (r JobRunResource) getActivityLogEntry(
+	ctx context.Context,
+	client *v2.ClientWithResponses,
+	taskId string,
+	runId int64,
+) (*v2.ActivityLogEntry, error) {
+
+	apiRes, apiErr := client.GetActivityLogWithResponse(ctx, &v2.GetActivityLogParams{
+		TaskId: &taskId,
+		RunId:  &runId,
+	})
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if resErr := apiRes.RequireStatus(200); resErr != nil {
+		return nil, resErr
+	}
+
+	for _, entry := range utils.ValOr(apiRes.JSON200, nil) {
+		if utils.Val(entry.RunId) == runId {
+			return &entry, nil
+		}
+	}
+	return nil, nil
+
+}
+
+// updateJobRunState applies the outcome of a run, treating a missing entry as
+// still running.
+func (r JobRunResource) updateJobRunState(state *JobRunResourceModel, entry *v2.ActivityLogEntry) {
+	if entry == nil {
+		state.Status = types.StringValue(JobRunStatusRunning)
+		state.StartTime = types.StringNull()
+		state.EndTime = types.StringNull()
+		state.SuccessSourceRows = types.Int64Null()
+		state.FailedSourceRows = types.Int64Null()
+		state.SuccessTargetRows = types.Int64Null()
+		state.FailedTargetRows = types.Int64Null()
+		state.ErrorMessage = types.StringNull()
+		return
+	}
+
+	state.Status = types.StringValue(jobRunStatus(entry.State))
+	if entry.ObjectName != nil {
+		state.TaskName = types.StringValue(*entry.ObjectName)
+	}
+	state.StartTime = types.StringPointerValue(entry.StartTime)
+	state.EndTime = types.StringPointerValue(entry.EndTime)
+	state.SuccessSourceRows = types.Int64PointerValue(entry.SuccessSourceRows)
+	state.FailedSourceRows = types.Int64PointerValue(entry.FailedSourceRows)
+	state.SuccessTargetRows = types.Int64PointerValue(entry.SuccessTargetRows)
+	state.FailedTargetRows = types.Int64PointerValue(entry.FailedTargetRows)
+	state.ErrorMessage = OptionalStringValue(entry.ErrorMsg)
+
+}
+
+// jobRunStatus converts an activity log entry state into a run status.
+func jobRunStatus(state *int) string {
+	if status, ok := jobRunStatuses[utils.Val(state)]; ok {
+		return status
+	}
+	return fmt.Sprintf("UNKNOWN(%d)", utils.Val(state))
+}
+
+func parseJobRunDuration(diags DiagsHandler, value types.String, fallback time.Duration) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return fallback
+	}
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddError("Unable to parse duration: %s", err)
+		return fallback
+	}
+	if duration <= 0 {
+		diags.AddError("Duration must be positive.")
+		return fallback
+	}
+	return duration
+}
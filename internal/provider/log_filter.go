@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/common"
+
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+// logFilter holds the filters shared by the log data sources. The IDMC logs
+// are returned most recent first, so paging can stop as soon as an entry
+// older than the start of the time range turns up.
+type logFilter struct {
+	start      *time.Time
+	end        *time.Time
+	user       string
+	maxResults int
+}
+
+// logDefaultMaxResults bounds the entries returned when neither a start time
+// nor a maximum is given, so the whole log isn't paged through on every plan.
+const logDefaultMaxResults = 200
+
+func newLogFilter(diags DiagsHandler, start timetypes.RFC3339, end timetypes.RFC3339, user types.String, maxResults types.Int64) logFilter {
+	filter := logFilter{
+		start:      logFilterTime(diags.AtName("start_time"), start),
+		end:        logFilterTime(diags.AtName("end_time"), end),
+		user:       user.ValueString(),
+		maxResults: int(maxResults.ValueInt64()),
+	}
+	if filter.start != nil && filter.end != nil && filter.end.Before(*filter.start) {
+		diags.AtName("end_time").AddError("The end of the time range can't be before the start.")
+	}
+	if filter.maxResults < 0 {
+		diags.AtName("max_results").AddError("The maximum number of results can't be negative.")
+	}
+	if maxResults.IsNull() && filter.start == nil {
+		filter.maxResults = logDefaultMaxResults
+	}
+	return filter
+}
+
+func logFilterTime(diags DiagsHandler, value timetypes.RFC3339) *time.Time {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	result, resultDiags := value.ValueRFC3339Time()
+	if diags.Append(resultDiags) {
+		return nil
+	}
+	return &result
+}
+
+// beforeStart reports whether an entry is older than the time range, meaning
+// every entry after it will be too.
+func (f logFilter) beforeStart(entryTime *time.Time) bool {
+	return f.start != nil && entryTime != nil && entryTime.Before(*f.start)
+}
+
+// matches reports whether an entry falls within the time range and was made
+// by the requested user. Entries without a time are only excluded by users.
+func (f logFilter) matches(entryTime *time.Time, user *string) bool {
+	if f.beforeStart(entryTime) {
+		return false
+	}
+	if f.end != nil && entryTime != nil && entryTime.After(*f.end) {
+		return false
+	}
+	if f.user != "" && (user == nil || !strings.EqualFold(*user, f.user)) {
+		return false
+	}
+	return true
+}
+
+// full reports whether enough entries have been collected.
+func (f logFilter) full(count int) bool {
+	return f.maxResults > 0 && count >= f.maxResults
+}
+
+// collectLogEntries pages through a log, keeping the entries that match the
+// filter (and the extra check, if any), until the log runs out, the start of
+// the time range is passed, or enough entries have been collected.
+func collectLogEntries[Entry any](
+	ctx context.Context,
+	filter logFilter,
+	pages *common.Paginator[Entry],
+	entryTime func(entry Entry) *time.Time,
+	entryUser func(entry Entry) *string,
+	keep func(entry Entry) bool,
+) ([]Entry, error) {
+	var entries []Entry
+	for pages.Next(ctx) {
+		passedStart := false
+		for _, entry := range pages.Page() {
+			timestamp := entryTime(entry)
+			if filter.beforeStart(timestamp) {
+				passedStart = true
+				continue
+			}
+			if filter.matches(timestamp, entryUser(entry)) && keep(entry) {
+				entries = append(entries, entry)
+			}
+		}
+		if passedStart || filter.full(len(entries)) {
+			break
+		}
+	}
+	if filter.full(len(entries)) {
+		entries = entries[:filter.maxResults]
+	}
+	return entries, pages.Err()
+}
+
+// parseLogTime parses the timestamps of log entries, ignoring any that can't
+// be, since they can still be filtered by everything else.
+func parseLogTime(text *string) *time.Time {
+	if text == nil {
+		return nil
+	}
+	result, err := time.Parse(time.RFC3339, *text)
+	if err != nil {
+		return nil
+	}
+	return &result
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/common"
+
+	. "github.com/onsi/gomega"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+type fakeLogEntry struct {
+	time time.Time
+	user string
+}
+
+func TestCollectLogEntries(t *testing.T) {
+	RegisterTestingT(t)
+
+	// An entry every hour, most recent first, alternating between users.
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	var fetches int
+	pages := common.NewPaginator(5, func(ctx context.Context, limit int32, skip int32) ([]fakeLogEntry, error) {
+		fetches++
+		page := make([]fakeLogEntry, limit)
+		for index := range page {
+			offset := int(skip) + index
+			page[index] = fakeLogEntry{
+				time: now.Add(-time.Duration(offset) * time.Hour),
+				user: []string{"alice", "bob"}[offset%2],
+			}
+		}
+		return page, nil
+	})
+
+	var diagnostics diag.Diagnostics
+	filter := newLogFilter(NewDiagsHandler(&diagnostics, MsgDataSourceBadRead),
+		timetypes.NewRFC3339TimeValue(now.Add(-12*time.Hour)),
+		timetypes.NewRFC3339TimeValue(now.Add(-2*time.Hour)),
+		types.StringValue("ALICE"),
+		types.Int64Null(),
+	)
+	Expect(diagnostics.HasError()).To(BeFalse())
+
+	entries, err := collectLogEntries(context.TODO(), filter, pages,
+		func(entry fakeLogEntry) *time.Time { return &entry.time },
+		func(entry fakeLogEntry) *string { return &entry.user },
+		func(entry fakeLogEntry) bool { return true },
+	)
+
+	Expect(err).To(BeNil())
+	Expect(entries).To(HaveLen(6))
+	Expect(entries[0].time).To(Equal(now.Add(-2 * time.Hour)))
+	Expect(entries[5].time).To(Equal(now.Add(-12 * time.Hour)))
+
+	// Paging should stop once the start of the range has been passed.
+	Expect(fetches).To(Equal(3))
+
+}
+
+func TestLogFilterDefaultMaxResults(t *testing.T) {
+	RegisterTestingT(t)
+
+	var diagnostics diag.Diagnostics
+	diags := NewDiagsHandler(&diagnostics, MsgDataSourceBadRead)
+	start := timetypes.NewRFC3339TimeValue(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	// Without any bound, only the most recent entries are collected.
+	filter := newLogFilter(diags, timetypes.NewRFC3339Null(), timetypes.NewRFC3339Null(), types.StringNull(), types.Int64Null())
+	Expect(filter.maxResults).To(Equal(logDefaultMaxResults))
+
+	// A start time is enough of a bound on its own.
+	filter = newLogFilter(diags, start, timetypes.NewRFC3339Null(), types.StringNull(), types.Int64Null())
+	Expect(filter.full(logDefaultMaxResults)).To(BeFalse())
+
+	// And an explicit maximum always applies.
+	filter = newLogFilter(diags, timetypes.NewRFC3339Null(), timetypes.NewRFC3339Null(), types.StringNull(), types.Int64Value(10))
+	Expect(filter.maxResults).To(Equal(10))
+	Expect(diagnostics).To(BeEmpty())
+
+}
@@ -0,0 +1,391 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/common"
+	"terraform-provider-idmc/internal/idmc/v2"
+	"terraform-provider-idmc/internal/utils"
+
+	. "github.com/hashicorp/terraform-plugin-framework/resource"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+var _ ResourceWithConfigure = &MappingTaskResource{}
+
+type MappingTaskResource struct {
+	*IdmcProviderResource
+}
+
+func NewMappingTaskResource() Resource {
+	return &MappingTaskResource{
+		&IdmcProviderResource{},
+	}
+}
+
+type MappingTaskResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	OrgId                types.String `tfsdk:"org_id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	MappingId            types.String `tfsdk:"mapping_id"`
+	RuntimeEnvironmentId types.String `tfsdk:"runtime_environment_id"`
+	ScheduleId           types.String `tfsdk:"schedule_id"`
+	ParameterFileDir     types.String `tfsdk:"parameter_file_dir"`
+	ParameterFileName    types.String `tfsdk:"parameter_file_name"`
+	SessionProperties    types.Map    `tfsdk:"session_properties"`
+	PreProcessingCmd     types.String `tfsdk:"pre_processing_cmd"`
+	PostProcessingCmd    types.String `tfsdk:"post_processing_cmd"`
+	FederatedId          types.String `tfsdk:"federated_id"`
+	CreatedTime          types.String `tfsdk:"created_time"`
+	UpdatedTime          types.String `tfsdk:"updated_time"`
+	CreatedBy            types.String `tfsdk:"created_by"`
+	UpdatedBy            types.String `tfsdk:"updated_by"`
+}
+
+// Metadata <editor-fold desc="Metadata" defaultstate="collapsed">
+func (r MappingTaskResource) Metadata(ctx context.Context, req MetadataRequest, resp *MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mapping_task"
+}
+
+// </editor-fold>
+
+// Schema <editor-fold desc="Schema" defaultstate="collapsed">
+func (r MappingTaskResource) Schema(ctx context.Context, req SchemaRequest, resp *SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "https://docs.informatica.com/integration-cloud/data-integration/current-version/rest-api-reference/data-integration-rest-api/mapping-tasks.html",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Mapping task ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the mapping task.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the mapping task.",
+				Optional:    true,
+			},
+			"mapping_id": schema.StringAttribute{
+				Description: "ID of the existing mapping the task runs.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"runtime_environment_id": schema.StringAttribute{
+				Description: "ID of the runtime environment the task runs on.",
+				Required:    true,
+			},
+			"schedule_id": schema.StringAttribute{
+				Description: "ID of the schedule that runs the task. The task is only run manually when unset.",
+				Optional:    true,
+			},
+			"parameter_file_dir": schema.StringAttribute{
+				Description: "Path of the directory containing the parameter file, on the Secure Agent machine.",
+				Optional:    true,
+			},
+			"parameter_file_name": schema.StringAttribute{
+				Description: "Name of the parameter file.",
+				Optional:    true,
+			},
+			"session_properties": schema.MapAttribute{
+				Description: "Advanced session properties, keyed by property name. Only the configured properties are managed.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"pre_processing_cmd": schema.StringAttribute{
+				Description: "Command to run before the task.",
+				Optional:    true,
+			},
+			"post_processing_cmd": schema.StringAttribute{
+				Description: "Command to run after the task.",
+				Optional:    true,
+			},
+			"org_id": schema.StringAttribute{
+				Description: "Organization ID.",
+				Computed:    true,
+			},
+			"federated_id": schema.StringAttribute{
+				Description: "Global unique identifier.",
+				Computed:    true,
+			},
+			"created_by": schema.StringAttribute{
+				Description: "User who created the mapping task.",
+				Computed:    true,
+			},
+			"updated_by": schema.StringAttribute{
+				Description: "User who last updated the mapping task.",
+				Computed:    true,
+			},
+			"created_time": schema.StringAttribute{
+				Description: "Date and time the mapping task was created.",
+				Computed:    true,
+			},
+			"updated_time": schema.StringAttribute{
+				Description: "Date and time the mapping task was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// </editor-fold>
+
+// Create <editor-fold desc="Create" defaultstate="collapsed">
+func (r MappingTaskResource) Create(ctx context.Context, req CreateRequest, resp *CreateResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadCreate)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load configuration from plan.
+	var data MappingTaskResourceModel
+	if diags.Append(req.Plan.Get(ctx, &data)) {
+		return
+	}
+
+	reqBody := r.newMappingTaskData(ctx, diags, &data)
+	if diags.HasError() {
+		return
+	}
+
+	apiRes, apiErr := client.CreateMappingTaskWithResponse(ctx, reqBody)
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Handle error responses.
+	if diags.HandleError(apiRes.RequireStatus(200)) {
+		return
+	}
+
+	if r.updateMappingTaskState(diags, &data, apiRes.JSON200) {
+		return
+	}
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &data))
+
+}
+
+// </editor-fold>
+
+// Read <editor-fold desc="Read" defaultstate="collapsed">
+func (r MappingTaskResource) Read(ctx context.Context, req ReadRequest, resp *ReadResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadRead)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load the previous state.
+	var data MappingTaskResourceModel
+	if diags.Append(req.State.Get(ctx, &data)) {
+		return
+	}
+
+	if data.Id.IsNull() {
+		diags.WithPath(path.Root("id")).AddError(
+			"Resource id is missing.")
+		return
+	}
+
+	// Perform the API request.
+	apiRes, apiErr := client.GetMappingTaskWithResponse(ctx, data.Id.ValueString())
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Remove the resource if not found, otherwise handle error responses.
+	resErr := apiRes.RequireStatus(200)
+	if errors.Is(resErr, common.ErrNotFound) {
+		RemoveMissingResource(ctx, diags, &resp.State, "mapping task", data.Id.ValueString())
+		return
+	}
+	if diags.HandleError(resErr) {
+		return
+	}
+
+	if r.updateMappingTaskState(diags, &data, apiRes.JSON200) {
+		return
+	}
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &data))
+
+}
+
+// </editor-fold>
+
+// Update <editor-fold desc="Update" defaultstate="collapsed">
+func (r MappingTaskResource) Update(ctx context.Context, req UpdateRequest, resp *UpdateResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadUpdate)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load configuration from plan.
+	var plan MappingTaskResourceModel
+	if diags.Append(req.Plan.Get(ctx, &plan)) {
+		return
+	}
+
+	// A full update is used so that any removed settings are cleared as well.
+	reqBody := r.newMappingTaskData(ctx, diags, &plan)
+	if diags.HasError() {
+		return
+	}
+
+	apiRes, apiErr := client.UpdateMappingTaskWithResponse(ctx, plan.Id.ValueString(),
+		&v2.UpdateMappingTaskParams{
+			UpdateMode: utils.Ptr(v2.UpdateMappingTaskParamsUpdateModeFULL),
+		},
+		reqBody,
+	)
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Handle error responses.
+	if diags.HandleError(apiRes.RequireStatus(200)) {
+		return
+	}
+
+	if r.updateMappingTaskState(diags, &plan, apiRes.JSON200) {
+		return
+	}
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &plan))
+
+}
+
+// </editor-fold>
+
+// Delete <editor-fold desc="Delete" defaultstate="collapsed">
+func (r MappingTaskResource) Delete(ctx context.Context, req DeleteRequest, resp *DeleteResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadDelete)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load the previous state.
+	var data MappingTaskResourceModel
+	if diags.Append(req.State.Get(ctx, &data)) {
+		return
+	}
+
+	apiRes, apiErr := client.DeleteMappingTaskWithResponse(ctx, data.Id.ValueString())
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Handle error responses, but consider it done if it's already gone.
+	resErr := apiRes.RequireStatus(200)
+	if !errors.Is(resErr, common.ErrNotFound) && diags.HandleError(resErr) {
+		return
+	}
+
+}
+
+// </editor-fold>
+
+func (r MappingTaskResource) newMappingTaskData(
+	ctx context.Context,
+	diags DiagsHandler,
+	data *MappingTaskResourceModel,
+) v2.MappingTaskData {
+
+	var sessionProperties *map[string]string
+	if !data.SessionProperties.IsNull() && !data.SessionProperties.IsUnknown() {
+		properties := make(map[string]string, len(data.SessionProperties.Elements()))
+		diags.AtName("session_properties").Append(data.SessionProperties.ElementsAs(ctx, &properties, false))
+		sessionProperties = &properties
+	}
+
+	return v2.MappingTaskData{
+		Type:                 utils.Ptr(v2.MappingTaskDataTypeMtTask),
+		Name:                 data.Name.ValueString(),
+		Description:          data.Description.ValueStringPointer(),
+		MappingId:            data.MappingId.ValueString(),
+		RuntimeEnvironmentId: data.RuntimeEnvironmentId.ValueString(),
+		ScheduleId:           data.ScheduleId.ValueStringPointer(),
+		ParameterFileDir:     data.ParameterFileDir.ValueStringPointer(),
+		ParameterFileName:    data.ParameterFileName.ValueStringPointer(),
+		SessionProperties:    sessionProperties,
+		PreProcessingCmd:     data.PreProcessingCmd.ValueStringPointer(),
+		PostProcessingCmd:    data.PostProcessingCmd.ValueStringPointer(),
+	}
+
+}
+
+func (r MappingTaskResource) updateMappingTaskState(
+	diags DiagsHandler,
+	state *MappingTaskResourceModel,
+	data *v2.MappingTask,
+) bool {
+	if data == nil {
+		diags.AddError("no mapping task response data provided")
+		return true
+	}
+
+	// Update the configured state so instabilities can be detected.
+	state.Id = types.StringPointerValue(data.Id)
+	state.Name = types.StringValue(data.Name)
+	state.Description = OptionalStringValue(data.Description)
+	state.MappingId = types.StringValue(data.MappingId)
+	state.RuntimeEnvironmentId = types.StringValue(data.RuntimeEnvironmentId)
+	state.ScheduleId = OptionalStringValue(data.ScheduleId)
+	state.ParameterFileDir = OptionalStringValue(data.ParameterFileDir)
+	state.ParameterFileName = OptionalStringValue(data.ParameterFileName)
+	state.PreProcessingCmd = OptionalStringValue(data.PreProcessingCmd)
+	state.PostProcessingCmd = OptionalStringValue(data.PostProcessingCmd)
+
+	// Update derived values
+	state.OrgId = types.StringPointerValue(data.OrgId)
+	state.FederatedId = types.StringPointerValue(data.FrsGuid)
+	state.CreatedBy = types.StringPointerValue(data.CreatedBy)
+	state.UpdatedBy = types.StringPointerValue(data.UpdatedBy)
+	state.CreatedTime = types.StringPointerValue(data.CreateTime)
+	state.UpdatedTime = types.StringPointerValue(data.UpdateTime)
+
+	// Only the configured properties are tracked, as the api may echo back
+	// defaults for others. Those no longer set in IDMC drop out to show drift.
+	if state.SessionProperties.IsNull() || state.SessionProperties.IsUnknown() {
+		return diags.HasError()
+	}
+	properties := utils.ValOr(data.SessionProperties, nil)
+	managed := map[string]attr.Value{}
+	for name := range state.SessionProperties.Elements() {
+		if value, ok := properties[name]; ok {
+			managed[name] = types.StringValue(value)
+		}
+	}
+	state.SessionProperties = diags.AtName("session_properties").MapValue(types.StringType, managed)
+
+	return diags.HasError()
+
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	. "github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ Function = &ObjectPathFunction{}
+var _ Function = &SplitObjectPathFunction{}
+
+// objectPathMaxDepth is the deepest an asset can be nested in IDMC, being
+// within a folder within a project.
+const objectPathMaxDepth = 3
+
+type ObjectPathFunction struct{}
+
+func NewObjectPathFunction() Function {
+	return &ObjectPathFunction{}
+}
+
+func (f *ObjectPathFunction) Metadata(_ context.Context, _ MetadataRequest, resp *MetadataResponse) {
+	resp.Name = "object_path"
+}
+
+func (f *ObjectPathFunction) Definition(_ context.Context, _ DefinitionRequest, resp *DefinitionResponse) {
+	resp.Definition = Definition{
+		Summary: "Joins project, folder, and asset names into an IDMC object path.",
+		Description: "Produces paths such as 'Project/Folder/Asset', validating that there are no more than " +
+			"three segments, and that none of them are empty or contain a '/'.",
+		VariadicParameter: StringParameter{
+			Name:        "segments",
+			Description: "The project, and optionally folder and asset, names to join.",
+		},
+		Return: StringReturn{},
+	}
+}
+
+func (f *ObjectPathFunction) Run(ctx context.Context, req RunRequest, resp *RunResponse) {
+	var segments []string
+	if resp.Error = req.Arguments.Get(ctx, &segments); resp.Error != nil {
+		return
+	}
+
+	if err := validateObjectPath(segments); err != nil {
+		resp.Error = NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, strings.Join(segments, "/"))
+}
+
+type SplitObjectPathFunction struct{}
+
+func NewSplitObjectPathFunction() Function {
+	return &SplitObjectPathFunction{}
+}
+
+func (f *SplitObjectPathFunction) Metadata(_ context.Context, _ MetadataRequest, resp *MetadataResponse) {
+	resp.Name = "split_object_path"
+}
+
+func (f *SplitObjectPathFunction) Definition(_ context.Context, _ DefinitionRequest, resp *DefinitionResponse) {
+	resp.Definition = Definition{
+		Summary: "Splits an IDMC object path into its project, folder, and asset names.",
+		Description: "The reverse of object_path, turning paths such as 'Project/Folder/Asset' into a list " +
+			"of their segments. Any leading or trailing '/' is ignored.",
+		Parameters: []Parameter{
+			StringParameter{
+				Name:        "path",
+				Description: "The object path to split.",
+			},
+		},
+		Return: ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *SplitObjectPathFunction) Run(ctx context.Context, req RunRequest, resp *RunResponse) {
+	var path string
+	if resp.Error = req.Arguments.Get(ctx, &path); resp.Error != nil {
+		return
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if err := validateObjectPath(segments); err != nil {
+		resp.Error = NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, segments)
+}
+
+func validateObjectPath(segments []string) error {
+	if len(segments) < 1 || len(segments) > objectPathMaxDepth {
+		return fmt.Errorf("object paths must have between 1 and %d segments, not %d", objectPathMaxDepth, len(segments))
+	}
+	for index, segment := range segments {
+		if strings.TrimSpace(segment) == "" {
+			return fmt.Errorf("segment %d of the object path is empty", index)
+		}
+		if strings.Contains(segment, "/") {
+			return fmt.Errorf("segment %d of the object path contains a '/': %s", index, segment)
+		}
+	}
+	return nil
+}
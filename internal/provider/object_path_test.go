@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	. "github.com/hashicorp/terraform-plugin-framework/function"
+	. "github.com/onsi/gomega"
+)
+
+func runObjectPath(segments ...string) (attr.Value, *FuncError) {
+	elementTypes := make([]attr.Type, len(segments))
+	elements := make([]attr.Value, len(segments))
+	for index, segment := range segments {
+		elementTypes[index] = types.StringType
+		elements[index] = types.StringValue(segment)
+	}
+
+	resp := &RunResponse{Result: NewResultData(types.StringUnknown())}
+	(&ObjectPathFunction{}).Run(context.Background(), RunRequest{
+		Arguments: NewArgumentsData([]attr.Value{types.TupleValueMust(elementTypes, elements)}),
+	}, resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func runSplitObjectPath(path string) (attr.Value, *FuncError) {
+	resp := &RunResponse{Result: NewResultData(types.ListUnknown(types.StringType))}
+	(&SplitObjectPathFunction{}).Run(context.Background(), RunRequest{
+		Arguments: NewArgumentsData([]attr.Value{types.StringValue(path)}),
+	}, resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestObjectPath(t *testing.T) {
+	RegisterTestingT(t)
+
+	path, pathErr := runObjectPath("Project", "Folder", "Asset")
+	Expect(pathErr).To(BeNil())
+	Expect(path).To(Equal(types.StringValue("Project/Folder/Asset")))
+
+	path, pathErr = runObjectPath("Project")
+	Expect(pathErr).To(BeNil())
+	Expect(path).To(Equal(types.StringValue("Project")))
+
+	_, deepErr := runObjectPath("Project", "Folder", "Subfolder", "Asset")
+	Expect(deepErr).NotTo(BeNil())
+	Expect(deepErr.Text).To(ContainSubstring("between 1 and 3 segments"))
+
+	_, emptyErr := runObjectPath("Project", " ", "Asset")
+	Expect(emptyErr).NotTo(BeNil())
+	Expect(emptyErr.Text).To(ContainSubstring("segment 1 of the object path is empty"))
+
+	_, slashErr := runObjectPath("Project", "Folder/Asset")
+	Expect(slashErr).NotTo(BeNil())
+	Expect(slashErr.Text).To(ContainSubstring("contains a '/'"))
+
+}
+
+func TestSplitObjectPath(t *testing.T) {
+	RegisterTestingT(t)
+
+	segments, splitErr := runSplitObjectPath("/Project/Folder/Asset/")
+	Expect(splitErr).To(BeNil())
+	Expect(segments).To(Equal(types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("Project"),
+		types.StringValue("Folder"),
+		types.StringValue("Asset"),
+	})))
+
+	_, emptyErr := runSplitObjectPath("Project//Asset")
+	Expect(emptyErr).NotTo(BeNil())
+
+	_, deepErr := runSplitObjectPath("A/B/C/D")
+	Expect(deepErr).NotTo(BeNil())
+
+	_, blankErr := runSplitObjectPath("")
+	Expect(blankErr).NotTo(BeNil())
+
+}
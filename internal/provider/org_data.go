@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/v2"
+	"terraform-provider-idmc/internal/utils"
+
+	. "github.com/hashicorp/terraform-plugin-framework/datasource"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+var _ DataSourceWithConfigure = &OrgDataSource{}
+
+type OrgDataSource struct {
+	*IdmcProviderDataSource
+}
+
+func NewOrgDataSource() DataSource {
+	return &OrgDataSource{
+		&IdmcProviderDataSource{},
+	}
+}
+
+type OrgDataSourceModel struct {
+	Id               types.String      `tfsdk:"id"`
+	ParentOrgId      types.String      `tfsdk:"parent_org_id"`
+	OrgType          types.String      `tfsdk:"org_type"`
+	Name             types.String      `tfsdk:"name"`
+	Description      types.String      `tfsdk:"description"`
+	Address1         types.String      `tfsdk:"address1"`
+	Address2         types.String      `tfsdk:"address2"`
+	City             types.String      `tfsdk:"city"`
+	State            types.String      `tfsdk:"state"`
+	Zipcode          types.String      `tfsdk:"zipcode"`
+	Country          types.String      `tfsdk:"country"`
+	Timezone         types.String      `tfsdk:"timezone"`
+	ContactFirstName types.String      `tfsdk:"contact_first_name"`
+	ContactLastName  types.String      `tfsdk:"contact_last_name"`
+	ContactEmail     types.String      `tfsdk:"contact_email"`
+	ContactPhone     types.String      `tfsdk:"contact_phone"`
+	SessionTimeout   types.Int64       `tfsdk:"session_timeout"`
+	SubOrgs          types.List        `tfsdk:"sub_orgs"`
+	CreatedTime      timetypes.RFC3339 `tfsdk:"created_time"`
+	UpdatedTime      timetypes.RFC3339 `tfsdk:"updated_time"`
+	CreatedBy        types.String      `tfsdk:"created_by"`
+	UpdatedBy        types.String      `tfsdk:"updated_by"`
+}
+
+func (d *OrgDataSource) Metadata(_ context.Context, req MetadataRequest, resp *MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org"
+}
+
+func (d *OrgDataSource) Schema(_ context.Context, _ SchemaRequest, resp *SchemaResponse) {
+	computed := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Computed:    true,
+		}
+	}
+	resp.Schema = schema.Schema{
+		Description: "https://docs.informatica.com/integration-cloud/data-integration/current-version/rest-api-reference/platform-rest-api-version-2-resources/org.html",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the organization to read. Defaults to the organization the provider is logged in to.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"parent_org_id":      computed("ID of the parent organization, if this is a sub-organization."),
+			"org_type":           computed("Type of the organization, such as 'PARENT' or 'SUB'."),
+			"name":               computed("Name of the organization."),
+			"description":        computed("Description of the organization."),
+			"address1":           computed("First line of the organization's address."),
+			"address2":           computed("Second line of the organization's address."),
+			"city":               computed("City of the organization's address."),
+			"state":              computed("State of the organization's address."),
+			"zipcode":            computed("Postal code of the organization's address."),
+			"country":            computed("Country of the organization's address."),
+			"timezone":           computed("Default time zone of the organization."),
+			"contact_first_name": computed("First name of the organization's contact."),
+			"contact_last_name":  computed("Last name of the organization's contact."),
+			"contact_email":      computed("Email address of the organization's contact."),
+			"contact_phone":      computed("Phone number of the organization's contact."),
+			"created_by":         computed("User who created the organization."),
+			"updated_by":         computed("User who last updated the organization."),
+			"session_timeout": schema.Int64Attribute{
+				Description: "Number of minutes a session can be idle before it times out.",
+				Computed:    true,
+			},
+			"sub_orgs": schema.ListNestedAttribute{
+				Description: "The sub-organizations of the organization.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":   computed("Sub-organization ID."),
+						"name": computed("Name of the sub-organization."),
+					},
+				},
+			},
+			"created_time": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Description: "Date and time the organization was created.",
+				Computed:    true,
+			},
+			"updated_time": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Description: "Date and time the organization was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+var orgDataSubOrgType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+	},
+}
+
+func (d *OrgDataSource) Read(ctx context.Context, req ReadRequest, resp *ReadResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgDataSourceBadRead)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := d.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load the previous state if present.
+	var config OrgDataSourceModel
+	if diags.Append(req.Config.Get(ctx, &config)) {
+		return
+	}
+
+	// Read either the requested, or current organization.
+	var org *v2.Org
+	if config.Id.IsNull() {
+		apiRes, apiErr := client.GetCurrentOrgWithResponse(ctx)
+		if diags.HandleError(apiErr) || diags.HandleError(apiRes.RequireStatus(200)) {
+			return
+		}
+		org = apiRes.JSON200
+	} else {
+		apiRes, apiErr := client.GetOrgWithResponse(ctx, config.Id.ValueString())
+		if diags.HandleError(apiErr) || diags.HandleError(apiRes.RequireStatus(200)) {
+			return
+		}
+		org = apiRes.JSON200
+	}
+	if org == nil {
+		diags.AddError("no organization response data provided")
+		return
+	}
+
+	config.Id = types.StringPointerValue(org.Id)
+	config.ParentOrgId = OptionalStringValue(org.ParentOrgId)
+	config.OrgType = types.StringPointerValue(org.OrgType)
+	config.Name = types.StringValue(org.Name)
+	config.Description = types.StringPointerValue(org.Description)
+	config.Address1 = types.StringPointerValue(org.Address1)
+	config.Address2 = types.StringPointerValue(org.Address2)
+	config.City = types.StringPointerValue(org.City)
+	config.State = types.StringPointerValue(org.State)
+	config.Zipcode = types.StringPointerValue(org.Zipcode)
+	config.Country = types.StringPointerValue(org.Country)
+	config.Timezone = types.StringPointerValue(org.Timezone)
+	config.ContactFirstName = types.StringPointerValue(org.ContactFirstName)
+	config.ContactLastName = types.StringPointerValue(org.ContactLastName)
+	config.ContactEmail = types.StringPointerValue(org.ContactEmail)
+	config.ContactPhone = types.StringPointerValue(org.ContactPhone)
+	config.SessionTimeout = types.Int64PointerValue(org.SessionTimeout)
+	config.CreatedBy = types.StringPointerValue(org.CreatedBy)
+	config.UpdatedBy = types.StringPointerValue(org.UpdatedBy)
+	config.CreatedTime = diags.AtName("created_time").TimePointer(org.CreateTime)
+	config.UpdatedTime = diags.AtName("updated_time").TimePointer(org.UpdateTime)
+
+	subOrgsDiags := diags.AtName("sub_orgs")
+	subOrgs := utils.ValOr(org.SubOrgs, nil)
+	subOrgAttrs := make([]attr.Value, len(subOrgs))
+	for index, subOrg := range subOrgs {
+		subOrgAttrs[index] = subOrgsDiags.AtListIndex(index).ObjectValue(orgDataSubOrgType.AttrTypes, map[string]attr.Value{
+			"id":   types.StringPointerValue(subOrg.Id),
+			"name": types.StringPointerValue(subOrg.Name),
+		})
+	}
+	config.SubOrgs = subOrgsDiags.ListValue(orgDataSubOrgType, subOrgAttrs)
+	if diags.HasError() {
+		return
+	}
+
+	// Update the state and add the result
+	diags.Append(resp.State.Set(ctx, &config))
+
+}
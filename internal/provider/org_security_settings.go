@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/v2"
+	"terraform-provider-idmc/internal/utils"
+
+	. "github.com/hashicorp/terraform-plugin-framework/resource"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+var _ ResourceWithConfigure = &OrgSecuritySettingsResource{}
+
+type OrgSecuritySettingsResource struct {
+	*IdmcProviderResource
+}
+
+func NewOrgSecuritySettingsResource() Resource {
+	return &OrgSecuritySettingsResource{
+		&IdmcProviderResource{},
+	}
+}
+
+type OrgSecuritySettingsResourceModel struct {
+	Id                       types.String `tfsdk:"id"`
+	SessionTimeout           types.Int64  `tfsdk:"session_timeout"`
+	MinPasswordLength        types.Int64  `tfsdk:"min_password_length"`
+	MinPasswordCharMix       types.Int64  `tfsdk:"min_password_char_mix"`
+	PasswordReuseInDays      types.Int64  `tfsdk:"password_reuse_in_days"`
+	PasswordExpirationInDays types.Int64  `tfsdk:"password_expiration_in_days"`
+	TwoFactorAuth            types.Bool   `tfsdk:"two_factor_auth"`
+}
+
+// Metadata <editor-fold desc="Metadata" defaultstate="collapsed">
+func (r OrgSecuritySettingsResource) Metadata(ctx context.Context, req MetadataRequest, resp *MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_security_settings"
+}
+
+// </editor-fold>
+
+// Schema <editor-fold desc="Schema" defaultstate="collapsed">
+func (r OrgSecuritySettingsResource) Schema(ctx context.Context, req SchemaRequest, resp *SchemaResponse) {
+	optionalInt64 := func(description string, validators ...validator.Int64) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Description: description + " Left as is when not configured.",
+			Optional:    true,
+			Computed:    true,
+			Validators:  validators,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	resp.Schema = schema.Schema{
+		Description: "The security settings of the organization the provider is logged in to. " +
+			"Only one of these should be declared per organization, and destroying it leaves the settings as they are. " +
+			"https://docs.informatica.com/integration-cloud/data-integration/current-version/rest-api-reference/platform-rest-api-version-2-resources/org.html",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the organization the settings apply to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"session_timeout": optionalInt64(
+				"Number of minutes a session can be idle before it times out.",
+				int64validator.AtLeast(1),
+			),
+			"min_password_length": optionalInt64(
+				"Minimum number of characters a user password must contain.",
+				int64validator.AtLeast(1),
+			),
+			"min_password_char_mix": optionalInt64(
+				"Number of character classes (lowercase, uppercase, numeric, special) a user password must mix.",
+				int64validator.Between(1, 4),
+			),
+			"password_reuse_in_days": optionalInt64(
+				"Number of days before a user can reuse a previous password.",
+				int64validator.AtLeast(0),
+			),
+			"password_expiration_in_days": optionalInt64(
+				"Number of days until a user password expires.",
+				int64validator.AtLeast(0),
+			),
+			"two_factor_auth": schema.BoolAttribute{
+				Description: "Whether users must use two-factor authentication to log in. Left as is when not configured.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// </editor-fold>
+
+// Create <editor-fold desc="Create" defaultstate="collapsed">
+func (r OrgSecuritySettingsResource) Create(ctx context.Context, req CreateRequest, resp *CreateResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadCreate)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load configuration from plan.
+	var plan OrgSecuritySettingsResourceModel
+	if diags.Append(req.Plan.Get(ctx, &plan)) {
+		return
+	}
+
+	if plan.apply(ctx, diags, client) {
+		return
+	}
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &plan))
+
+}
+
+// </editor-fold>
+
+// Read <editor-fold desc="Read" defaultstate="collapsed">
+func (r OrgSecuritySettingsResource) Read(ctx context.Context, req ReadRequest, resp *ReadResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadRead)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load the previous state.
+	var data OrgSecuritySettingsResourceModel
+	if diags.Append(req.State.Get(ctx, &data)) {
+		return
+	}
+
+	apiRes, apiErr := client.GetCurrentOrgWithResponse(ctx)
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Handle error responses.
+	if diags.HandleError(apiRes.RequireStatus(200)) {
+		return
+	}
+
+	if data.updateState(diags, apiRes.JSON200) {
+		return
+	}
+
+	// Save updated data into Terraform state.
+	diags.Append(resp.State.Set(ctx, &data))
+
+}
+
+// </editor-fold>
+
+// Update <editor-fold desc="Update" defaultstate="collapsed">
+func (r OrgSecuritySettingsResource) Update(ctx context.Context, req UpdateRequest, resp *UpdateResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadUpdate)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load configuration from plan.
+	var plan OrgSecuritySettingsResourceModel
+	if diags.Append(req.Plan.Get(ctx, &plan)) {
+		return
+	}
+
+	if plan.apply(ctx, diags, client) {
+		return
+	}
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &plan))
+
+}
+
+// </editor-fold>
+
+// Delete <editor-fold desc="Delete" defaultstate="collapsed">
+func (r OrgSecuritySettingsResource) Delete(ctx context.Context, req DeleteRequest, resp *DeleteResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadDelete)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	// There's no sensible default to reset the organization's policy to, so
+	// the settings are just forgotten.
+	diags.WithTitle("Organization security settings left in place").AddWarning(
+		"The security settings of the organization have not been changed, and will no longer be managed.",
+	)
+
+}
+
+// </editor-fold>
+
+// apply sends the known settings in the model to the current organization,
+// leaving the rest as they are, and updates the model with the result.
+func (m *OrgSecuritySettingsResourceModel) apply(ctx context.Context, diags DiagsHandler, client *v2.ClientWithResponses) bool {
+
+	// The update replaces the whole organization, so it's fetched first.
+	getRes, getErr := client.GetCurrentOrgWithResponse(ctx)
+	if diags.HandleError(getErr) || diags.HandleError(getRes.RequireStatus(200)) {
+		return true
+	}
+	if getRes.JSON200 == nil || getRes.JSON200.Id == nil {
+		diags.AddError("no organization response data provided")
+		return true
+	}
+	org := getRes.JSON200
+
+	apiRes, apiErr := client.UpdateOrgWithResponse(ctx, *org.Id, m.newOrgData(org))
+	if diags.HandleError(apiErr) || diags.HandleError(apiRes.RequireStatus(200)) {
+		return true
+	}
+
+	return m.updateState(diags, apiRes.JSON200)
+}
+
+// newOrgData builds the update request from the current organization, as the
+// update replaces every field, overriding only the configured settings.
+func (m *OrgSecuritySettingsResourceModel) newOrgData(org *v2.Org) v2.OrgData {
+	return v2.OrgData{
+		Type:                     utils.Ptr(v2.OrgDataTypeOrg),
+		Name:                     org.Name,
+		Description:              org.Description,
+		Address1:                 org.Address1,
+		Address2:                 org.Address2,
+		City:                     org.City,
+		State:                    org.State,
+		Zipcode:                  org.Zipcode,
+		Country:                  org.Country,
+		Timezone:                 org.Timezone,
+		ContactFirstName:         org.ContactFirstName,
+		ContactLastName:          org.ContactLastName,
+		ContactEmail:             org.ContactEmail,
+		ContactPhone:             org.ContactPhone,
+		SessionTimeout:           orgSecurityInt64(m.SessionTimeout, org.SessionTimeout),
+		MinPasswordLength:        orgSecurityInt64(m.MinPasswordLength, org.MinPasswordLength),
+		MinPasswordCharMix:       orgSecurityInt64(m.MinPasswordCharMix, org.MinPasswordCharMix),
+		PasswordReuseInDays:      orgSecurityInt64(m.PasswordReuseInDays, org.PasswordReuseInDays),
+		PasswordExpirationInDays: orgSecurityInt64(m.PasswordExpirationInDays, org.PasswordExpirationInDays),
+		EnableTwoFactorAuth:      orgSecurityBool(m.TwoFactorAuth, org.EnableTwoFactorAuth),
+	}
+}
+
+func orgSecurityInt64(value types.Int64, current *int64) *int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return current
+	}
+	return value.ValueInt64Pointer()
+}
+
+func orgSecurityBool(value types.Bool, current *bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return current
+	}
+	return value.ValueBoolPointer()
+}
+
+func (m *OrgSecuritySettingsResourceModel) updateState(diags DiagsHandler, data *v2.Org) bool {
+	if data == nil {
+		diags.AddError("no organization response data provided")
+		return true
+	}
+
+	m.Id = types.StringPointerValue(data.Id)
+	m.SessionTimeout = types.Int64PointerValue(data.SessionTimeout)
+	m.MinPasswordLength = types.Int64PointerValue(data.MinPasswordLength)
+	m.MinPasswordCharMix = types.Int64PointerValue(data.MinPasswordCharMix)
+	m.PasswordReuseInDays = types.Int64PointerValue(data.PasswordReuseInDays)
+	m.PasswordExpirationInDays = types.Int64PointerValue(data.PasswordExpirationInDays)
+	m.TwoFactorAuth = types.BoolPointerValue(data.EnableTwoFactorAuth)
+
+	return false
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/v2"
+	"terraform-provider-idmc/internal/utils"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestOrgSecuritySettingsNewOrgData(t *testing.T) {
+	RegisterTestingT(t)
+
+	org := &v2.Org{
+		Name:                     "Example",
+		SessionTimeout:           utils.Ptr(int64(30)),
+		MinPasswordLength:        utils.Ptr(int64(12)),
+		MinPasswordCharMix:       utils.Ptr(int64(3)),
+		PasswordReuseInDays:      utils.Ptr(int64(90)),
+		PasswordExpirationInDays: utils.Ptr(int64(180)),
+		EnableTwoFactorAuth:      utils.Ptr(true),
+	}
+
+	// Only the session timeout is configured, as when adopting the settings.
+	model := OrgSecuritySettingsResourceModel{
+		SessionTimeout:           types.Int64Value(60),
+		MinPasswordLength:        types.Int64Unknown(),
+		MinPasswordCharMix:       types.Int64Unknown(),
+		PasswordReuseInDays:      types.Int64Null(),
+		PasswordExpirationInDays: types.Int64Unknown(),
+		TwoFactorAuth:            types.BoolUnknown(),
+	}
+
+	data := model.newOrgData(org)
+	Expect(data.Name).To(Equal("Example"))
+	Expect(data.SessionTimeout).To(Equal(utils.Ptr(int64(60))))
+	Expect(data.MinPasswordLength).To(Equal(org.MinPasswordLength))
+	Expect(data.MinPasswordCharMix).To(Equal(org.MinPasswordCharMix))
+	Expect(data.PasswordReuseInDays).To(Equal(org.PasswordReuseInDays))
+	Expect(data.PasswordExpirationInDays).To(Equal(org.PasswordExpirationInDays))
+	Expect(data.EnableTwoFactorAuth).To(Equal(org.EnableTwoFactorAuth))
+
+	model.TwoFactorAuth = types.BoolValue(false)
+	Expect(model.newOrgData(org).EnableTwoFactorAuth).To(Equal(utils.Ptr(false)))
+
+}
+
+func TestOrgSecuritySettingsNewOrgDataKeepsDetails(t *testing.T) {
+	RegisterTestingT(t)
+
+	org := &v2.Org{
+		Name:             "Example",
+		Description:      utils.Ptr("Example organization"),
+		Address1:         utils.Ptr("1 Example Street"),
+		Address2:         utils.Ptr("Level 2"),
+		City:             utils.Ptr("Wellington"),
+		State:            utils.Ptr("Wellington"),
+		Zipcode:          utils.Ptr("6011"),
+		Country:          utils.Ptr("NZ"),
+		Timezone:         utils.Ptr("Pacific/Auckland"),
+		ContactFirstName: utils.Ptr("Jo"),
+		ContactLastName:  utils.Ptr("Bloggs"),
+		ContactEmail:     utils.Ptr("jo@example.com"),
+		ContactPhone:     utils.Ptr("+64 4 000 0000"),
+	}
+
+	model := OrgSecuritySettingsResourceModel{
+		SessionTimeout:           types.Int64Value(60),
+		MinPasswordLength:        types.Int64Null(),
+		MinPasswordCharMix:       types.Int64Null(),
+		PasswordReuseInDays:      types.Int64Null(),
+		PasswordExpirationInDays: types.Int64Null(),
+		TwoFactorAuth:            types.BoolNull(),
+	}
+
+	data := model.newOrgData(org)
+	Expect(data.Description).To(Equal(org.Description))
+	Expect(data.Address1).To(Equal(org.Address1))
+	Expect(data.Address2).To(Equal(org.Address2))
+	Expect(data.City).To(Equal(org.City))
+	Expect(data.State).To(Equal(org.State))
+	Expect(data.Zipcode).To(Equal(org.Zipcode))
+	Expect(data.Country).To(Equal(org.Country))
+	Expect(data.Timezone).To(Equal(org.Timezone))
+	Expect(data.ContactFirstName).To(Equal(org.ContactFirstName))
+	Expect(data.ContactLastName).To(Equal(org.ContactLastName))
+	Expect(data.ContactEmail).To(Equal(org.ContactEmail))
+	Expect(data.ContactPhone).To(Equal(org.ContactPhone))
+
+}
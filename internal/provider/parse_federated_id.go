@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	. "github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ Function = &ParseFederatedIdFunction{}
+
+type ParseFederatedIdFunction struct{}
+
+func NewParseFederatedIdFunction() Function {
+	return &ParseFederatedIdFunction{}
+}
+
+// federatedIdPattern matches the 22 character base62 ids IDMC uses to globally
+// identify objects.
+var federatedIdPattern = regexp.MustCompile(`^[0-9A-Za-z]{22}$`)
+
+func (f *ParseFederatedIdFunction) Metadata(_ context.Context, _ MetadataRequest, resp *MetadataResponse) {
+	resp.Name = "parse_federated_id"
+}
+
+func (f *ParseFederatedIdFunction) Definition(_ context.Context, _ DefinitionRequest, resp *DefinitionResponse) {
+	resp.Definition = Definition{
+		Summary: "Extracts a bare federated id from an IDMC object reference.",
+		Description: "Accepts a federated id on its own, or in the '@<id>' and 'saas:@<id>' reference forms " +
+			"used by the IDMC apis, and returns the bare 22 character id. Fails if the id isn't valid.",
+		Parameters: []Parameter{
+			StringParameter{
+				Name:        "reference",
+				Description: "The federated id or object reference to parse.",
+			},
+		},
+		Return: StringReturn{},
+	}
+}
+
+func (f *ParseFederatedIdFunction) Run(ctx context.Context, req RunRequest, resp *RunResponse) {
+	var reference string
+	if resp.Error = req.Arguments.Get(ctx, &reference); resp.Error != nil {
+		return
+	}
+
+	federatedId, err := parseFederatedId(reference)
+	if err != nil {
+		resp.Error = NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, federatedId)
+}
+
+func parseFederatedId(reference string) (string, error) {
+	federatedId := strings.TrimPrefix(strings.TrimSpace(reference), "saas:")
+	federatedId = strings.TrimPrefix(federatedId, "@")
+	if !federatedIdPattern.MatchString(federatedId) {
+		return "", fmt.Errorf("'%s' is not a valid federated id, which must be 22 alphanumeric characters", reference)
+	}
+	return federatedId, nil
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseFederatedId(t *testing.T) {
+	RegisterTestingT(t)
+
+	for _, reference := range []string{
+		"0123456789abcdefghijkl",
+		"@0123456789abcdefghijkl",
+		"saas:@0123456789abcdefghijkl",
+	} {
+		Expect(parseFederatedId(reference)).To(Equal("0123456789abcdefghijkl"))
+	}
+
+	_, shortErr := parseFederatedId("saas:@0123")
+	Expect(shortErr).To(HaveOccurred())
+
+	_, symbolErr := parseFederatedId("0123456789abcdefghij-_")
+	Expect(symbolErr).To(HaveOccurred())
+
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	. "github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ Function = &PodFromUrlFunction{}
+
+type PodFromUrlFunction struct{}
+
+func NewPodFromUrlFunction() Function {
+	return &PodFromUrlFunction{}
+}
+
+// idmcDomain is the domain all IDMC pods are hosted under.
+const idmcDomain = "informaticacloud.com"
+
+func (f *PodFromUrlFunction) Metadata(_ context.Context, _ MetadataRequest, resp *MetadataResponse) {
+	resp.Name = "pod_from_url"
+}
+
+func (f *PodFromUrlFunction) Definition(_ context.Context, _ DefinitionRequest, resp *DefinitionResponse) {
+	resp.Definition = Definition{
+		Summary: "Extracts the IDMC pod name from a pod url.",
+		Description: "Given a url such as 'https://usw3.dm-us.informaticacloud.com/saas', returns the pod " +
+			"('usw3'). Fails for urls that aren't pod-specific, such as the login host.",
+		Parameters: []Parameter{
+			StringParameter{
+				Name:        "url",
+				Description: "The pod url (or host name) to inspect.",
+			},
+		},
+		Return: StringReturn{},
+	}
+}
+
+func (f *PodFromUrlFunction) Run(ctx context.Context, req RunRequest, resp *RunResponse) {
+	var podUrl string
+	if resp.Error = req.Arguments.Get(ctx, &podUrl); resp.Error != nil {
+		return
+	}
+
+	pod, err := podFromUrl(podUrl)
+	if err != nil {
+		resp.Error = NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, pod)
+}
+
+func podFromUrl(podUrl string) (string, error) {
+
+	// Allow bare host names by giving them a scheme to parse with.
+	if !strings.Contains(podUrl, "://") {
+		podUrl = "https://" + podUrl
+	}
+
+	parsedUrl, err := url.Parse(podUrl)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse url: %w", err)
+	}
+
+	// Pod hosts look like <pod>.<region>.informaticacloud.com
+	host := strings.ToLower(parsedUrl.Hostname())
+	if !strings.HasSuffix(host, "."+idmcDomain) {
+		return "", fmt.Errorf("'%s' is not an %s host", host, idmcDomain)
+	}
+	labels := strings.Split(strings.TrimSuffix(host, "."+idmcDomain), ".")
+	if len(labels) != 2 || labels[0] == "" {
+		return "", fmt.Errorf("'%s' is not a pod-specific host", host)
+	}
+
+	return labels[0], nil
+}
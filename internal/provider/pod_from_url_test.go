@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPodFromUrl(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(podFromUrl("https://usw3.dm-us.informaticacloud.com/saas")).To(Equal("usw3"))
+	Expect(podFromUrl("NA1.DM-US.informaticacloud.com")).To(Equal("na1"))
+
+	_, loginErr := podFromUrl("https://dm-us.informaticacloud.com/ma")
+	Expect(loginErr).To(MatchError(ContainSubstring("not a pod-specific host")))
+
+	_, otherErr := podFromUrl("https://usw3.dm-us.example.com")
+	Expect(otherErr).To(MatchError(ContainSubstring("not an informaticacloud.com host")))
+
+}
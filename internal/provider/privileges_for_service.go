@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ Function = &PrivilegesForServiceFunction{}
+
+type PrivilegesForServiceFunction struct{}
+
+func NewPrivilegesForServiceFunction() Function {
+	return &PrivilegesForServiceFunction{}
+}
+
+func (f *PrivilegesForServiceFunction) Metadata(_ context.Context, _ MetadataRequest, resp *MetadataResponse) {
+	resp.Name = "privileges_for_service"
+}
+
+func (f *PrivilegesForServiceFunction) Definition(_ context.Context, _ DefinitionRequest, resp *DefinitionResponse) {
+	resp.Definition = Definition{
+		Summary: "Filters a list of privileges down to those of a single service.",
+		Description: "Intended for use with the privileges of the idmc_role_privilege_list data source. The " +
+			"service name is matched case-insensitively.",
+		Parameters: []Parameter{
+			ListParameter{
+				Name:        "privileges",
+				Description: "The privileges to filter.",
+				ElementType: privilegeDataItemType,
+			},
+			StringParameter{
+				Name:        "service",
+				Description: "The name of the service to keep privileges for, such as 'Data Integration'.",
+			},
+		},
+		Return: ListReturn{
+			ElementType: privilegeDataItemType,
+		},
+	}
+}
+
+func (f *PrivilegesForServiceFunction) Run(ctx context.Context, req RunRequest, resp *RunResponse) {
+	var privileges []RolePrivilegeListDataSourceModelPrivilege
+	var service string
+	if resp.Error = req.Arguments.Get(ctx, &privileges, &service); resp.Error != nil {
+		return
+	}
+
+	result := make([]RolePrivilegeListDataSourceModelPrivilege, 0, len(privileges))
+	for _, privilege := range privileges {
+		if strings.EqualFold(privilege.Service.ValueString(), service) {
+			result = append(result, privilege)
+		}
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	. "github.com/hashicorp/terraform-plugin-framework/function"
+	. "github.com/onsi/gomega"
+)
+
+func testPrivilegeObject(name string, service string) attr.Value {
+	return types.ObjectValueMust(privilegeDataItemType.AttrTypes, map[string]attr.Value{
+		"id":          types.StringValue(name + "Id"),
+		"name":        types.StringValue(name),
+		"description": types.StringNull(),
+		"service":     types.StringValue(service),
+		"status":      types.StringValue("Enabled"),
+	})
+}
+
+func TestPrivilegesForService(t *testing.T) {
+	RegisterTestingT(t)
+
+	privileges := types.ListValueMust(privilegeDataItemType, []attr.Value{
+		testPrivilegeObject("view.di.mapping", "Data Integration"),
+		testPrivilegeObject("view.apim.apic.asset.api", "API Center"),
+		testPrivilegeObject("edit.di.mapping", "Data Integration"),
+	})
+
+	resp := &RunResponse{Result: NewResultData(types.ListUnknown(privilegeDataItemType))}
+	(&PrivilegesForServiceFunction{}).Run(context.Background(), RunRequest{
+		Arguments: NewArgumentsData([]attr.Value{privileges, types.StringValue("data integration")}),
+	}, resp)
+	Expect(resp.Error).To(BeNil())
+
+	// Matched case-insensitively, keeping the original order.
+	Expect(resp.Result.Value()).To(Equal(types.ListValueMust(privilegeDataItemType, []attr.Value{
+		testPrivilegeObject("view.di.mapping", "Data Integration"),
+		testPrivilegeObject("edit.di.mapping", "Data Integration"),
+	})))
+
+}
@@ -5,10 +5,14 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +21,7 @@ import (
 	"terraform-provider-idmc/internal/idmc"
 	"terraform-provider-idmc/internal/idmc/common"
 	"terraform-provider-idmc/internal/idmc/v3"
+	"terraform-provider-idmc/internal/utils"
 
 	. "terraform-provider-idmc/internal/provider/utils"
 )
@@ -26,15 +31,23 @@ const MsgProviderBadConfigure = "Unable to configure provider"
 // Ensure IdmcProvider satisfies various provider interfaces.
 var _ provider.Provider = &IdmcProvider{}
 var _ provider.ProviderWithFunctions = &IdmcProvider{}
+var _ provider.ProviderWithEphemeralResources = &IdmcProvider{}
+var _ provider.ProviderWithConfigValidators = &IdmcProvider{}
 
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
-		return &IdmcProvider{
-			version: version,
-			IdmcProviderData: &IdmcProviderData{
-				Api: nil,
-			},
-		}
+		return NewIdmcProvider(version)
+	}
+}
+
+// NewIdmcProvider creates a provider instance directly, for callers that need
+// to Close it once the plugin server stops.
+func NewIdmcProvider(version string) *IdmcProvider {
+	return &IdmcProvider{
+		version: version,
+		IdmcProviderData: &IdmcProviderData{
+			Api: nil,
+		},
 	}
 }
 
@@ -45,6 +58,10 @@ type IdmcProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+	// ownedSession is the session the provider logged in with itself, which
+	// needs to be logged out on shutdown. Externally supplied sessions are
+	// never recorded here.
+	ownedSession *IdmcSession
 }
 
 // IdmcProviderModel describes the provider data model.
@@ -52,6 +69,20 @@ type IdmcProviderModel struct {
 	AuthHost types.String `tfsdk:"auth_host"`
 	AuthUser types.String `tfsdk:"auth_user"`
 	AuthPass types.String `tfsdk:"auth_pass"`
+	OrgId    types.String `tfsdk:"org_id"`
+
+	SessionId  types.String `tfsdk:"session_id"`
+	BaseApiUrl types.String `tfsdk:"base_api_url"`
+
+	ProxyUrl           types.String `tfsdk:"proxy_url"`
+	CaCertFile         types.String `tfsdk:"ca_cert_file"`
+	CaCertPem          types.String `tfsdk:"ca_cert_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ClientCertFile     types.String `tfsdk:"client_cert_file"`
+	ClientCertPem      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyFile      types.String `tfsdk:"client_key_file"`
+	ClientKeyPem       types.String `tfsdk:"client_key_pem"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
 }
 
 func (p *IdmcProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -76,10 +107,77 @@ func (p *IdmcProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"org_id": schema.StringAttribute{
+				Description: "ID of a sub-organization to operate within, when logging in with a user of its parent organization.",
+				Optional:    true,
+			},
+			"session_id": schema.StringAttribute{
+				Description: "An existing IDMC session to use instead of logging in. Requires 'base_api_url', and is never logged out by the provider.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"base_api_url": schema.StringAttribute{
+				Description: "The base url of the pod hosting the org that 'session_id' belongs to.",
+				Optional:    true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the HTTP proxy to send all IDMC requests through. Defaults to the standard HTTPS_PROXY/NO_PROXY environment variables.",
+				Optional:    true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM-encoded CA bundle used to verify the IDMC (or proxy) TLS certificates, in addition to the system roots.",
+				Optional:    true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded CA bundle used to verify the IDMC (or proxy) TLS certificates, in addition to the system roots.",
+				Optional:    true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Disables TLS certificate verification. Only ever use this for debugging, since it exposes credentials to interception.",
+				Optional:    true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM-encoded client certificate presented for mutual TLS.",
+				Optional:    true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded client certificate presented for mutual TLS.",
+				Optional:    true,
+			},
+			"client_key_file": schema.StringAttribute{
+				Description: "Path to the PEM-encoded private key for the mutual TLS client certificate.",
+				Optional:    true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				Description: "PEM-encoded private key for the mutual TLS client certificate.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Maximum duration of any single IDMC request, such as '30s' or '2m'. No timeout is applied by default.",
+				Optional:    true,
+			},
 		},
 	}
 }
 
+func (p *IdmcProvider) ConfigValidators(_ context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providervalidator.Conflicting(
+			path.MatchRoot("ca_cert_file"),
+			path.MatchRoot("ca_cert_pem"),
+		),
+		providervalidator.Conflicting(
+			path.MatchRoot("client_cert_file"),
+			path.MatchRoot("client_cert_pem"),
+		),
+		providervalidator.Conflicting(
+			path.MatchRoot("client_key_file"),
+			path.MatchRoot("client_key_pem"),
+		),
+	}
+}
+
 func getCfgVal(diags DiagsHandler, attrVal types.String, attrPath string, required bool) string {
 
 	// Check the attribute for a valid value.
@@ -106,6 +204,29 @@ func getCfgVal(diags DiagsHandler, attrVal types.String, attrPath string, requir
 
 }
 
+func getCfgBool(diags DiagsHandler, attrVal types.Bool, attrPath string) bool {
+
+	// Check the attribute for a valid value.
+	if !attrVal.IsNull() && !attrVal.IsUnknown() {
+		return attrVal.ValueBool()
+	}
+
+	// Check the environment for a valid value.
+	envKey := "IDMC_" + strings.ToUpper(attrPath)
+	val, ok := os.LookupEnv(envKey)
+	if !ok || val == "" {
+		return false
+	}
+
+	result, err := strconv.ParseBool(val)
+	if err != nil {
+		diags.AtName(attrPath).AddError(
+			"Unable to parse '%s' from the env as a boolean: %s", envKey, err)
+	}
+	return result
+
+}
+
 func (p *IdmcProvider) Configure(
 	ctx context.Context,
 	req provider.ConfigureRequest,
@@ -123,30 +244,54 @@ func (p *IdmcProvider) Configure(
 		tflog.Debug(ctx, "Re-using previously configured api.")
 		resp.DataSourceData = p.IdmcProviderData
 		resp.ResourceData = p.IdmcProviderData
+		resp.EphemeralResourceData = p.IdmcProviderData
 		return
 	}
 
+	// An externally supplied session replaces the need to log in.
+	sessionId := getCfgVal(diags, config.SessionId, "session_id", false)
+	baseApiUrl := getCfgVal(diags, config.BaseApiUrl, "base_api_url", sessionId != "")
+
 	// Extract config and validate all the required values are set.
-	authHost := getCfgVal(diags, config.AuthHost, "auth_host", true)
-	authUser := getCfgVal(diags, config.AuthUser, "auth_user", true)
-	authPass := getCfgVal(diags, config.AuthPass, "auth_pass", true)
+	authHost := getCfgVal(diags, config.AuthHost, "auth_host", sessionId == "")
+	authUser := getCfgVal(diags, config.AuthUser, "auth_user", sessionId == "")
+	authPass := getCfgVal(diags, config.AuthPass, "auth_pass", sessionId == "")
+	orgId := getCfgVal(diags, config.OrgId, "org_id", false)
 	if diags.HasError() {
 		return
 	}
 
 	tflog.Debug(ctx, "Setting-up IDMC api client", map[string]any{
-		"auth_host": authHost,
-		"auth_user": authUser,
+		"auth_host":        authHost,
+		"auth_user":        authUser,
+		"org_id":           orgId,
+		"external_session": sessionId != "",
 	})
 
-	httpClient := &http.Client{}
-
-	// TODO: Cache this with something like bitcask or just save the response json to file.
-	baseApiUrl, sessionId, loginErr := doLogin(ctx, authHost, authUser, authPass, httpClient)
-	if loginErr != nil {
-		diags.HandleError(loginErr)
+	httpClient := newHttpClient(diags, config)
+	if diags.HasError() {
 		return
 	}
+	p.HttpClient = httpClient
+
+	p.Logout = func(logoutCtx context.Context, session IdmcSession) error {
+		return doLogout(logoutCtx, session, httpClient)
+	}
+
+	// Only sessions the provider logs into itself are logged out on shutdown.
+	if sessionId == "" {
+		// TODO: Cache this with something like bitcask or just save the response json to file.
+		loginUrl, loginSession, loginErr := doLogin(ctx, authHost, authUser, authPass, orgId, httpClient)
+		if loginErr != nil {
+			diags.HandleError(loginErr)
+			return
+		}
+		baseApiUrl, sessionId = loginUrl, loginSession
+		p.ownedSession = &IdmcSession{
+			BaseApiUrl: baseApiUrl,
+			SessionId:  sessionId,
+		}
+	}
 
 	idmcApi, idmcApiErr := idmc.NewIdmcApi(baseApiUrl, sessionId,
 		common.WithHTTPClient(httpClient),
@@ -162,12 +307,37 @@ func (p *IdmcProvider) Configure(
 	}
 
 	p.Api = idmcApi
+	if authHost != "" && authUser != "" && authPass != "" {
+		p.Login = func(loginCtx context.Context) (*IdmcSession, error) {
+			loginUrl, loginSession, err := doLogin(loginCtx, authHost, authUser, authPass, orgId, httpClient)
+			if err != nil {
+				return nil, err
+			}
+			return &IdmcSession{
+				BaseApiUrl: loginUrl,
+				SessionId:  loginSession,
+			}, nil
+		}
+	}
+
 	resp.DataSourceData = p.IdmcProviderData
 	resp.ResourceData = p.IdmcProviderData
+	resp.EphemeralResourceData = p.IdmcProviderData
 
 }
 
-func doLogin(ctx context.Context, authHost string, authUser string, authPass string, httpClient common.HttpRequestDoer) (string, string, error) {
+// Close logs out the session the provider logged in with, if any. Sessions
+// supplied through the provider config belong to someone else, so are left be.
+func (p *IdmcProvider) Close(ctx context.Context) error {
+	if p.ownedSession == nil || p.Logout == nil {
+		return nil
+	}
+	session := *p.ownedSession
+	p.ownedSession = nil
+	return p.Logout(ctx, session)
+}
+
+func doLogin(ctx context.Context, authHost string, authUser string, authPass string, orgId string, httpClient common.HttpRequestDoer) (string, string, error) {
 	var apiUrl = fmt.Sprintf("https://%s/saas", authHost)
 
 	// First set up a client configured for api login (without logging requests).
@@ -183,17 +353,22 @@ func doLogin(ctx context.Context, authHost string, authUser string, authPass str
 		return apiUrl, "", clientErr
 	}
 
-	// Perform the login operation with the provided credentials.
-	res, resErr := client.LoginWithResponse(ctx, v3.LoginJSONRequestBody{
+	// Perform the login operation with the provided credentials, optionally
+	// within a sub-organization.
+	reqBody := v3.LoginJSONRequestBody{
 		Username: authUser,
 		Password: authPass,
-	})
+	}
+	if orgId != "" {
+		reqBody.OrgId = &orgId
+	}
+	res, resErr := client.LoginWithResponse(ctx, reqBody)
 	if resErr != nil {
 		return apiUrl, "", resErr
 	}
 
 	// We only want 200 responses.
-	if err := RequireHttpStatus(&res.ClientResponse, 200); err != nil {
+	if err := res.RequireStatus(200); err != nil {
 		return apiUrl, "", err
 	}
 	// TODO: Handle other responses.
@@ -214,6 +389,12 @@ func doLogin(ctx context.Context, authHost string, authUser string, authPass str
 	}
 	sessionId := *userData.SessionId
 
+	// Make sure the login actually switched to the requested organization, so
+	// nothing is quietly managed in the parent organization instead.
+	if orgId != "" && utils.Val(userData.OrgId) != orgId {
+		return apiUrl, "", fmt.Errorf("logged in to organization '%s' instead of the requested '%s'", utils.Val(userData.OrgId), orgId)
+	}
+
 	if resData.Products == nil {
 		return apiUrl, sessionId, fmt.Errorf("no products found in response")
 	}
@@ -230,22 +411,64 @@ func doLogin(ctx context.Context, authHost string, authUser string, authPass str
 
 }
 
+func doLogout(ctx context.Context, session IdmcSession, httpClient common.HttpRequestDoer) error {
+	api, apiErr := v3.NewIdmcAdminV3Api(session.BaseApiUrl, &session.SessionId,
+		common.WithHTTPClient(httpClient),
+		common.WithApiResponseEditorFn(LogApiResponse),
+	)
+	if apiErr != nil {
+		return apiErr
+	}
+
+	res, resErr := api.Client.LogoutWithResponse(ctx, &v3.LogoutParams{})
+	if resErr != nil {
+		return resErr
+	}
+	return res.RequireStatus(200, 204)
+
+}
+
 func (p *IdmcProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAgentConfigResource,
+		NewJobRunResource,
+		NewMappingTaskResource,
+		NewOrgSecuritySettingsResource,
 		NewRoleResource,
 		NewRuntimeEnvironmentResource,
+		NewSamlConfigResource,
+		NewSubOrgResource,
+		NewTaskflowPublicationResource,
+		NewTrustedIpRangesResource,
 	}
 }
 
 func (p *IdmcProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewActivityLogDataSource,
 		NewAgentInstallerDataSource,
+		NewAuditLogDataSource,
+		NewOrgDataSource,
 		NewRoleDataSource,
 		NewRoleListDataSource,
 		NewRolePrivilegeListDataSource,
+		NewSamlSpMetadataDataSource,
+	}
+}
+
+func (p *IdmcProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAgentInstallerEphemeralResource,
+		NewSessionEphemeralResource,
 	}
 }
 
 func (p *IdmcProvider) Functions(_ context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewObjectPathFunction,
+		NewParseFederatedIdFunction,
+		NewPodFromUrlFunction,
+		NewPrivilegesForServiceFunction,
+		NewSplitObjectPathFunction,
+	}
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 
 	. "github.com/onsi/gomega"
+	. "terraform-provider-idmc/internal/provider/utils"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -61,27 +62,84 @@ func TestDoLogin(t *testing.T) {
 		fakeSessionId,
 	)
 
-	baseApiUrl, sessionId, loginErr := doLogin(
-		ctx, authHost, authUser, authPass,
+	fakeHttpClient := common.NewHttpRequestDoerSimple(func(req *http.Request) (*http.Response, error) {
+		return utils.OkPtr(&http.Response{
+			Status:        "200 OK",
+			StatusCode:    200,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Body:          io.NopCloser(bytes.NewBufferString(fakeBody)),
+			ContentLength: int64(len(fakeBody)),
+			Request:       req,
+			Header: http.Header{
+				"Content-Type": {"application/json"},
+			},
+		})
+	})
+
+	baseApiUrl, sessionId, loginErr := doLogin(ctx, authHost, authUser, authPass, "", fakeHttpClient)
+
+	Expect(loginErr).To(BeNil())
+	Expect(baseApiUrl).To(Equal(fakeApiUrl))
+	Expect(sessionId).To(Equal(fakeSessionId))
+
+	// Logging in to the organization the response is for is fine.
+	_, sessionId, loginErr = doLogin(ctx, authHost, authUser, authPass, "0cuQSDTq5sikvN7x8r1xm1", fakeHttpClient)
+	Expect(loginErr).To(BeNil())
+	Expect(sessionId).To(Equal(fakeSessionId))
+
+	// But landing in any other organization is an error.
+	_, _, loginErr = doLogin(ctx, authHost, authUser, authPass, "1dvRTEUr6tjlwO8y9s2yn2", fakeHttpClient)
+	Expect(loginErr).To(MatchError(ContainSubstring("1dvRTEUr6tjlwO8y9s2yn2")))
+
+}
+
+func TestDoLogout(t *testing.T) {
+	RegisterTestingT(t)
+
+	session := IdmcSession{
+		BaseApiUrl: fmt.Sprintf("https://%s/saas", gofakeit.DomainName()),
+		SessionId:  gofakeit.LetterN(8),
+	}
+
+	var logoutReq *http.Request
+	logoutErr := doLogout(context.TODO(), session,
 		common.NewHttpRequestDoerSimple(func(req *http.Request) (*http.Response, error) {
+			logoutReq = req
 			return utils.OkPtr(&http.Response{
-				Status:        "200 OK",
-				StatusCode:    200,
-				Proto:         "HTTP/1.1",
-				ProtoMajor:    1,
-				ProtoMinor:    1,
-				Body:          io.NopCloser(bytes.NewBufferString(fakeBody)),
-				ContentLength: int64(len(fakeBody)),
-				Request:       req,
-				Header: http.Header{
-					"Content-Type": {"application/json"},
-				},
+				Status:     "200 OK",
+				StatusCode: 200,
+				Body:       io.NopCloser(bytes.NewBufferString("")),
+				Request:    req,
 			})
 		}),
 	)
 
-	Expect(loginErr).To(BeNil())
-	Expect(baseApiUrl).To(Equal(fakeApiUrl))
-	Expect(sessionId).To(Equal(fakeSessionId))
+	Expect(logoutErr).To(BeNil())
+	Expect(logoutReq.URL.String()).To(Equal(session.BaseApiUrl + "/public/core/v3/logout"))
+	Expect(logoutReq.Header["INFA-SESSION-ID"]).To(Equal([]string{session.SessionId}))
+
+}
+
+func TestProviderClose(t *testing.T) {
+	RegisterTestingT(t)
+
+	var loggedOut []IdmcSession
+	idmcProvider := NewIdmcProvider("test")
+	idmcProvider.Logout = func(ctx context.Context, session IdmcSession) error {
+		loggedOut = append(loggedOut, session)
+		return nil
+	}
+
+	// Externally supplied sessions aren't owned, so are left alone.
+	Expect(idmcProvider.Close(context.TODO())).To(Succeed())
+	Expect(loggedOut).To(BeEmpty())
+
+	owned := IdmcSession{BaseApiUrl: "https://example.com/saas", SessionId: "owned"}
+	idmcProvider.ownedSession = &owned
+	Expect(idmcProvider.Close(context.TODO())).To(Succeed())
+	Expect(idmcProvider.Close(context.TODO())).To(Succeed())
+	Expect(loggedOut).To(Equal([]IdmcSession{owned}))
 
 }
@@ -2,13 +2,20 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/common"
 	"terraform-provider-idmc/internal/idmc/v3"
 
 	. "github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +24,8 @@ import (
 )
 
 var _ ResourceWithConfigure = &RoleResource{}
+var _ ResourceWithModifyPlan = &RoleResource{}
+var _ ResourceWithConfigValidators = &RoleResource{}
 
 type RoleResource struct {
 	*IdmcProviderResource
@@ -33,6 +42,10 @@ type RoleResourceModel struct {
 	Name               types.String `tfsdk:"name"`
 	Description        types.String `tfsdk:"description"`
 	Privileges         types.Set    `tfsdk:"privileges"`
+	PrivilegeIds       types.Set    `tfsdk:"privilege_ids"`
+	CopyFromRole       types.String `tfsdk:"copy_from_role"`
+	AdditionalPrivs    types.Set    `tfsdk:"additional_privileges"`
+	ExcludedPrivs      types.Set    `tfsdk:"excluded_privileges"`
 	OrgId              types.String `tfsdk:"org_id"`
 	DisplayName        types.String `tfsdk:"display_name"`
 	DisplayDescription types.String `tfsdk:"display_description"`
@@ -64,27 +77,57 @@ func (r RoleResource) Schema(_ context.Context, _ SchemaRequest, resp *SchemaRes
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of the role.",
+				Description: "Name of the role. Changing this renames the role in place.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"description": schema.StringAttribute{
-				Description: "Description of the role.",
+				Description: "Description of the role. Leave it out rather than setting it empty to have none.",
 				Optional:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
 				},
 			},
 			"privileges": schema.SetAttribute{
-				Description: "The privileges assigned to the role.",
-				Required:    true,
+				Description: "The privileges assigned to the role, by either name or id. " +
+					"Kept as written, so names and ids can be mixed; use privilege_ids for a consistent form. " +
+					"Computed as ids from the copied role when copy_from_role is set.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"copy_from_role": schema.StringAttribute{
+				Description: "Name or id of a role, such as 'Designer', to take the privileges from. " +
+					"The role keeps following the privileges of the copied role.",
+				Optional: true,
+			},
+			"additional_privileges": schema.SetAttribute{
+				Description: "Privileges, by either name or id, to assign on top of those copied.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.AlsoRequires(path.MatchRoot("copy_from_role")),
+				},
+			},
+			"excluded_privileges": schema.SetAttribute{
+				Description: "Privileges, by either name or id, to leave out of those copied.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.AlsoRequires(path.MatchRoot("copy_from_role")),
+				},
+			},
+			"privilege_ids": schema.SetAttribute{
+				Description: "The ids of the privileges assigned to the role, however they were written in privileges. " +
+					"This is the normalised form to reference from elsewhere.",
+				Computed:    true,
 				ElementType: types.StringType,
 			},
 			"org_id": schema.StringAttribute{
 				Description: "ID of the organization the role belongs to.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"display_name": schema.StringAttribute{
 				Description: "Role name displayed in the user interface.",
@@ -97,14 +140,23 @@ func (r RoleResource) Schema(_ context.Context, _ SchemaRequest, resp *SchemaRes
 			"system_role": schema.BoolAttribute{
 				Description: "Whether the role is a system-defined role.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"status": schema.StringAttribute{
 				Description: "Whether the organization's license to use the role is valid or has expired.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"created_by": schema.StringAttribute{
 				Description: "User who created the role.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_by": schema.StringAttribute{
 				Description: "User who last updated the role.",
@@ -113,6 +165,9 @@ func (r RoleResource) Schema(_ context.Context, _ SchemaRequest, resp *SchemaRes
 			"created_time": schema.StringAttribute{
 				Description: "Date and time the role was created.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_time": schema.StringAttribute{
 				Description: "Date and time the role was last updated.",
@@ -124,6 +179,46 @@ func (r RoleResource) Schema(_ context.Context, _ SchemaRequest, resp *SchemaRes
 
 // </editor-fold>
 
+func (r RoleResource) ConfigValidators(_ context.Context) []ConfigValidator {
+	return []ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("privileges"),
+			path.MatchRoot("copy_from_role"),
+		),
+	}
+}
+
+// ModifyPlan <editor-fold desc="ModifyPlan" defaultstate="collapsed">
+func (r RoleResource) ModifyPlan(ctx context.Context, req ModifyPlanRequest, resp *ModifyPlanResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadPlan)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	// Nothing to check when destroying.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan RoleResourceModel
+	if diags.Append(req.Plan.Get(ctx, &plan)) {
+		return
+	}
+
+	// The provider may not be configured yet if its own settings aren't known.
+	if r.IdmcProviderData == nil {
+		return
+	}
+
+	if r.resolvePrivileges(ctx, diags, &plan) {
+		return
+	}
+
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("privileges"), plan.Privileges))
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root("privilege_ids"), plan.PrivilegeIds))
+
+}
+
+// </editor-fold>
+
 // Create <editor-fold desc="Create" defaultstate="collapsed">
 func (r RoleResource) Create(ctx context.Context, req CreateRequest, resp *CreateResponse) {
 	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadCreate)
@@ -140,8 +235,13 @@ func (r RoleResource) Create(ctx context.Context, req CreateRequest, resp *Creat
 		return
 	}
 
+	// Resolve privileges if planning couldn't.
+	if data.PrivilegeIds.IsUnknown() && r.resolvePrivileges(ctx, diags, &data) {
+		return
+	}
+
 	// Convert privilege set
-	rolePrivileges := data.getPrivileges(diags)
+	rolePrivileges := data.getPrivilegeIds(diags)
 	if diags.HasError() {
 		return
 	}
@@ -156,19 +256,7 @@ func (r RoleResource) Create(ctx context.Context, req CreateRequest, resp *Creat
 	}
 
 	// Handle error responses.
-	if apiRes.StatusCode() != 201 {
-		CheckApiErrorV3(diags,
-			apiRes.JSON400,
-			apiRes.JSON401,
-			apiRes.JSON403,
-			apiRes.JSON404,
-			apiRes.JSON500,
-			apiRes.JSON502,
-			apiRes.JSON503,
-		)
-		if !diags.HasError() {
-			diags.HandleError(RequireHttpStatus(&apiRes.ClientResponse, 201))
-		}
+	if diags.HandleError(apiRes.RequireStatus(201)) {
 		return
 	}
 
@@ -177,7 +265,7 @@ func (r RoleResource) Create(ctx context.Context, req CreateRequest, resp *Creat
 	// Update the configured state so instabilities can be detected.
 	data.Id = types.StringPointerValue(respData.Id)
 	data.Name = types.StringPointerValue(respData.RoleName)
-	data.Description = types.StringPointerValue(respData.Description)
+	data.Description = OptionalStringValue(respData.Description)
 
 	// Update derived values
 	data.OrgId = types.StringPointerValue(respData.OrgId)
@@ -220,9 +308,11 @@ func (r RoleResource) Read(ctx context.Context, req ReadRequest, resp *ReadRespo
 	params := &v3.GetRolesParams{
 		Expand: Ptr(v3.GetRolesParamsExpandPrivileges),
 	}
+	roleRef := data.Id.ValueString()
 	if !data.Id.IsNull() {
 		params.Q = Ptr(fmt.Sprintf("roleId==\"%s\"", data.Id.ValueString()))
 	} else if !data.Name.IsNull() {
+		roleRef = data.Name.ValueString()
 		params.Q = Ptr(fmt.Sprintf("roleName==\"%s\"", data.Name.ValueString()))
 		diags.AtName("id").WithTitle("Issue reading resource").AddWarning(
 			"No id for the role found in state. Falling back to name: %s", data.Name.ValueString())
@@ -238,14 +328,20 @@ func (r RoleResource) Read(ctx context.Context, req ReadRequest, resp *ReadRespo
 		return
 	}
 
-	if diags.HandleError(RequireHttpStatus(&apiRes.ClientResponse, 200)) {
+	// Remove the resource if not found, otherwise handle error responses.
+	resErr := apiRes.RequireStatus(200)
+	if errors.Is(resErr, common.ErrNotFound) {
+		RemoveMissingResource(ctx, diags, &resp.State, "role", roleRef)
+		return
+	}
+	if diags.HandleError(resErr) {
 		return
 	}
 
-	apiItems := *apiRes.JSON200
+	apiItems := ValOr(apiRes.JSON200, nil)
 	if len(apiItems) == 0 {
 		// No matching resources, so junk it.
-		resp.State.RemoveResource(ctx)
+		RemoveMissingResource(ctx, diags, &resp.State, "role", roleRef)
 		return
 	} else if len(apiItems) != 1 {
 		diags.AddError(
@@ -258,7 +354,7 @@ func (r RoleResource) Read(ctx context.Context, req ReadRequest, resp *ReadRespo
 	// Update the configured state so instabilities can be detected.
 	data.Id = types.StringPointerValue(apiItems[0].Id)
 	data.Name = types.StringPointerValue(apiItems[0].RoleName)
-	data.Description = types.StringPointerValue(apiItems[0].Description)
+	data.Description = OptionalStringValue(apiItems[0].Description)
 
 	// Update derived values
 	data.OrgId = types.StringPointerValue(apiItems[0].OrgId)
@@ -272,7 +368,7 @@ func (r RoleResource) Read(ctx context.Context, req ReadRequest, resp *ReadRespo
 	data.UpdatedTime = types.StringPointerValue(apiItems[0].UpdateTime)
 
 	// Handle more sketchy data
-	if data.setPrivileges(diags, apiItems[0].Privileges) {
+	if data.setPrivileges(ctx, diags, r.IdmcProviderData, apiItems[0].Privileges) {
 		return
 	}
 
@@ -285,7 +381,7 @@ func (r RoleResource) Read(ctx context.Context, req ReadRequest, resp *ReadRespo
 
 // Update <editor-fold desc="Update" defaultstate="collapsed">
 func (r RoleResource) Update(ctx context.Context, req UpdateRequest, resp *UpdateResponse) {
-	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadDelete)
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadUpdate)
 	defer func() { diags.HandlePanic(recover()) }()
 
 	client := r.GetApiClientV3(diags)
@@ -307,13 +403,49 @@ func (r RoleResource) Update(ctx context.Context, req UpdateRequest, resp *Updat
 		return
 	}
 
+	// Resolve privileges if planning couldn't.
+	if plan.PrivilegeIds.IsUnknown() && r.resolvePrivileges(ctx, diags, &plan) {
+		return
+	}
+
 	// Load privileges into sets from both configs.
-	planPrivileges := plan.getPrivileges(diags)
-	statePrivileges := state.getPrivileges(diags)
+	planPrivileges := plan.getPrivilegeIds(diags)
+	statePrivileges := state.getPrivilegeIds(diags)
 	if diags.HasError() {
 		return
 	}
 
+	// Rename or re-describe the role without detaching it from anyone. A
+	// removed description is cleared by sending it empty.
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) {
+		apiRes, apiErr := client.UpdateRoleWithResponse(
+			ctx,
+			plan.Id.ValueString(),
+			&v3.UpdateRoleParams{},
+			v3.UpdateRoleJSONRequestBody{
+				Name:        plan.Name.ValueStringPointer(),
+				Description: Ptr(plan.Description.ValueString()),
+			},
+		)
+		if diags.HandleError(apiErr) {
+			return
+		}
+
+		// Handle error responses.
+		if diags.HandleError(apiRes.RequireStatus(200)) {
+			return
+		}
+
+		// Pick up the derived values that follow the name and description.
+		if respData := apiRes.JSON200; respData != nil {
+			plan.DisplayName = types.StringPointerValue(respData.DisplayName)
+			plan.DisplayDescription = types.StringPointerValue(respData.DisplayDescription)
+			plan.UpdatedBy = types.StringPointerValue(respData.UpdatedBy)
+			plan.UpdatedTime = types.StringPointerValue(respData.UpdateTime)
+		}
+
+	}
+
 	privDiags := diags.AtName("privileges")
 
 	// Add all the privileges that need to be added
@@ -331,19 +463,7 @@ func (r RoleResource) Update(ctx context.Context, req UpdateRequest, resp *Updat
 		}
 
 		// Handle error responses.
-		if apiRes.StatusCode() != 200 {
-			CheckApiErrorV3(privDiags,
-				apiRes.JSON400,
-				apiRes.JSON401,
-				apiRes.JSON403,
-				apiRes.JSON404,
-				apiRes.JSON500,
-				apiRes.JSON502,
-				apiRes.JSON503,
-			)
-			if !privDiags.HasError() {
-				privDiags.HandleError(RequireHttpStatus(&apiRes.ClientResponse, 200))
-			}
+		if privDiags.HandleError(apiRes.RequireStatus(200)) {
 			return
 		}
 
@@ -364,24 +484,26 @@ func (r RoleResource) Update(ctx context.Context, req UpdateRequest, resp *Updat
 		}
 
 		// Handle error responses.
-		if apiRes.StatusCode() != 200 {
-			CheckApiErrorV3(privDiags,
-				apiRes.JSON400,
-				apiRes.JSON401,
-				apiRes.JSON403,
-				apiRes.JSON404,
-				apiRes.JSON500,
-				apiRes.JSON502,
-				apiRes.JSON503,
-			)
-			if !privDiags.HasError() {
-				privDiags.HandleError(RequireHttpStatus(&apiRes.ClientResponse, 200))
-			}
+		if privDiags.HandleError(apiRes.RequireStatus(200)) {
 			return
 		}
 
 	}
 
+	// Keep the previous derived values where the api didn't return new ones.
+	if plan.DisplayName.IsUnknown() {
+		plan.DisplayName = state.DisplayName
+	}
+	if plan.DisplayDescription.IsUnknown() {
+		plan.DisplayDescription = state.DisplayDescription
+	}
+	if plan.UpdatedBy.IsUnknown() {
+		plan.UpdatedBy = state.UpdatedBy
+	}
+	if plan.UpdatedTime.IsUnknown() {
+		plan.UpdatedTime = state.UpdatedTime
+	}
+
 	// Save update result back to state.
 	diags.Append(resp.State.Set(ctx, &plan))
 
@@ -410,7 +532,9 @@ func (r RoleResource) Delete(ctx context.Context, req DeleteRequest, resp *Delet
 		return
 	}
 
-	if diags.HandleError(RequireHttpStatus(&apiRes.ClientResponse, 200, 204)) {
+	// Handle error responses, but consider it done if it's already gone.
+	resErr := apiRes.RequireStatus(200, 204)
+	if !errors.Is(resErr, common.ErrNotFound) && diags.HandleError(resErr) {
 		return
 	}
 
@@ -421,10 +545,110 @@ func (r RoleResource) Delete(ctx context.Context, req DeleteRequest, resp *Delet
 
 // </editor-fold>
 
-func (r RoleResourceModel) getPrivileges(diags DiagsHandler) *HashSet[string] {
-	privilegesPath := path.Root("privileges")
+// resolvePrivileges updates the privilege ids from either the configured
+// privileges, or those of the copied role with the configured changes. Values
+// that aren't known yet are left unknown.
+func (r RoleResource) resolvePrivileges(ctx context.Context, diags DiagsHandler, data *RoleResourceModel) bool {
+	if data.CopyFromRole.IsNull() {
+		if data.Privileges.IsUnknown() {
+			data.PrivilegeIds = types.SetUnknown(types.StringType)
+			return false
+		}
+		privileges := r.GetPrivileges(ctx, diags)
+		if diags.HasError() {
+			return true
+		}
+		data.PrivilegeIds = resolvePrivilegeIds(diags.AtName("privileges"), data.Privileges, privileges)
+		return diags.HasError()
+	}
+
+	if data.CopyFromRole.IsUnknown() || data.AdditionalPrivs.IsUnknown() || data.ExcludedPrivs.IsUnknown() {
+		data.Privileges = types.SetUnknown(types.StringType)
+		data.PrivilegeIds = types.SetUnknown(types.StringType)
+		return false
+	}
+
+	privileges := r.GetPrivileges(ctx, diags)
+	if diags.HasError() {
+		return true
+	}
+	additional := resolvePrivilegeIds(diags.AtName("additional_privileges"), data.AdditionalPrivs, privileges)
+	excluded := resolvePrivilegeIds(diags.AtName("excluded_privileges"), data.ExcludedPrivs, privileges)
+	copied := r.getRolePrivilegeIds(ctx, diags.AtName("copy_from_role"), data.CopyFromRole.ValueString())
+	if diags.HasError() {
+		return true
+	}
+
+	ids := copied.
+		Union(RoleResourceModel{PrivilegeIds: additional}.getPrivilegeIds(diags)).
+		Without(RoleResourceModel{PrivilegeIds: excluded}.getPrivilegeIds(diags))
+	if ids.Size() == 0 {
+		diags.AtName("excluded_privileges").AddError("A role must have at least one privilege assigned to it.")
+		return true
+	}
+
+	idAttrs := make([]attr.Value, 0, ids.Size())
+	for _, id := range ids.ToSlice() {
+		idAttrs = append(idAttrs, types.StringValue(id))
+	}
+	data.PrivilegeIds = diags.AtName("privilege_ids").SetValue(types.StringType, idAttrs)
+	data.Privileges = data.PrivilegeIds
+	return diags.HasError()
+}
+
+// getRolePrivilegeIds reads the ids of the privileges assigned to a role,
+// given its name or id.
+func (r RoleResource) getRolePrivilegeIds(ctx context.Context, diags DiagsHandler, roleRef string) *HashSet[string] {
+	client := r.GetApiClientV3(diags)
+	if diags.HasError() {
+		return nil
+	}
+
+	// Try by id first, then by name, as ids and names don't look alike.
+	var item *v3.GetRolesResponseBodyItem
+	for _, query := range []string{"roleId==\"%s\"", "roleName==\"%s\""} {
+		apiRes, apiErr := client.GetRolesWithResponse(ctx, &v3.GetRolesParams{
+			Q:      Ptr(fmt.Sprintf(query, roleRef)),
+			Expand: Ptr(v3.GetRolesParamsExpandPrivileges),
+		})
+		if diags.HandleError(apiErr) {
+			return nil
+		}
+		resErr := apiRes.RequireStatus(200)
+		if errors.Is(resErr, common.ErrNotFound) {
+			continue
+		}
+		if diags.HandleError(resErr) {
+			return nil
+		}
+		if items := ValOr(apiRes.JSON200, nil); len(items) > 0 {
+			item = &items[0]
+			break
+		}
+	}
+	if item == nil {
+		diags.WithTitle("Unknown role").AddError("No role has the id or name '%s'.", roleRef)
+		return nil
+	}
+
 	return NewHashSetAfter(func(set *HashSet[string]) {
-		for _, element := range r.Privileges.Elements() {
+		for _, privilege := range ValOr(item.Privileges, nil) {
+			if privilege.Id != nil {
+				set.Add(*privilege.Id)
+			}
+		}
+	})
+}
+
+// getPrivilegeIds returns the resolved privilege ids, falling back to those
+// configured for states saved before ids were resolved.
+func (r RoleResourceModel) getPrivilegeIds(diags DiagsHandler) *HashSet[string] {
+	privileges, privilegesPath := r.PrivilegeIds, path.Root("privilege_ids")
+	if privileges.IsNull() || privileges.IsUnknown() {
+		privileges, privilegesPath = r.Privileges, path.Root("privileges")
+	}
+	return NewHashSetAfter(func(set *HashSet[string]) {
+		for _, element := range privileges.Elements() {
 			elementAttr, castOk := element.(types.String)
 			if castOk && !elementAttr.IsNull() && !elementAttr.IsUnknown() {
 				set.Add(elementAttr.ValueString())
@@ -436,30 +660,112 @@ func (r RoleResourceModel) getPrivileges(diags DiagsHandler) *HashSet[string] {
 	})
 }
 
-func (r RoleResourceModel) setPrivileges(diags DiagsHandler, items *[]v3.RolePrivilegeItem) bool {
+// setPrivileges updates the privileges from those in the api response, keeping
+// them as they were written where they still resolve to the same privilege.
+func (r *RoleResourceModel) setPrivileges(ctx context.Context, diags DiagsHandler, providerData *IdmcProviderData, items *[]v3.RolePrivilegeItem) bool {
 	diags = diags.AtName("privileges")
 
 	if items == nil {
 		diags.WithTitle("Issue handling resource API response").AddWarning(
 			"Expected role privilege data, but received nothing.")
 		r.Privileges = types.SetNull(types.StringType)
+		r.PrivilegeIds = types.SetNull(types.StringType)
 		return diags.HasError()
 	}
 
+	// Work out how each privilege was written, if any were written by name.
+	ids := map[string]bool{}
+	for _, item := range *items {
+		ids[Val(item.Id)] = true
+	}
+	written := map[string]string{}
+	byName := false
+	for _, element := range r.Privileges.Elements() {
+		if ref, ok := element.(types.String); ok {
+			written[ref.ValueString()] = ref.ValueString()
+			byName = byName || !ids[ref.ValueString()]
+		}
+	}
+	if byName {
+		for _, privilege := range providerData.GetPrivileges(ctx, diags) {
+			if ref, ok := written[Val(privilege.Name)]; ok && privilege.Id != nil {
+				written[*privilege.Id] = ref
+			}
+		}
+		if diags.HasError() {
+			return true
+		}
+	}
+
 	privAttrs := make([]attr.Value, len(*items))
+	privIdAttrs := make([]attr.Value, len(*items))
 	for index, item := range *items {
-		privAttrs[index] = types.StringPointerValue(item.Id)
+		privIdAttrs[index] = types.StringPointerValue(item.Id)
+		if ref, ok := written[Val(item.Id)]; ok {
+			privAttrs[index] = types.StringValue(ref)
+		} else {
+			privAttrs[index] = types.StringPointerValue(item.Id)
+		}
 	}
 
-	privAttr := diags.SetValue(types.StringType, privAttrs)
-	if diags.HasError() {
-		r.Privileges = types.SetUnknown(types.StringType)
-		return true
+	r.Privileges = diags.SetValue(types.StringType, privAttrs)
+	r.PrivilegeIds = diags.SetValue(types.StringType, privIdAttrs)
+	return diags.HasError()
+
+}
+
+// resolvePrivilegeIds looks up each privilege by id or name, reporting any that
+// don't exist or can't be assigned against the offending value.
+func resolvePrivilegeIds(diags DiagsHandler, privileges types.Set, available []v3.RolePrivilegeItem) types.Set {
+	byId := map[string]v3.RolePrivilegeItem{}
+	byName := map[string][]v3.RolePrivilegeItem{}
+	for _, privilege := range available {
+		if privilege.Id != nil {
+			byId[*privilege.Id] = privilege
+		}
+		if privilege.Name != nil {
+			byName[*privilege.Name] = append(byName[*privilege.Name], privilege)
+		}
 	}
 
-	r.Privileges = privAttr
-	return diags.HasError()
+	var ids []attr.Value
+	for _, element := range privileges.Elements() {
+		ref, ok := element.(types.String)
+		if !ok || ref.IsUnknown() {
+			return types.SetUnknown(types.StringType)
+		}
+		elementDiags := diags.AtSetValue(element)
+
+		privilege, found := byId[ref.ValueString()]
+		if !found {
+			switch matches := byName[ref.ValueString()]; len(matches) {
+			case 0:
+				elementDiags.WithTitle("Unknown privilege").AddError(
+					"No privilege has the id or name '%s'.", ref.ValueString())
+				continue
+			case 1:
+				privilege = matches[0]
+			default:
+				elementDiags.WithTitle("Ambiguous privilege").AddError(
+					"%d privileges are named '%s', so it needs to be referenced by id.", len(matches), ref.ValueString())
+				continue
+			}
+		}
+
+		switch Val((*string)(privilege.Status)) {
+		case string(v3.RolePrivilegeItemStatusDisabled):
+			elementDiags.WithTitle("Disabled privilege").AddError(
+				"The license to use the privilege '%s' has expired.", ref.ValueString())
+			continue
+		case string(v3.RolePrivilegeItemStatusUnassigned):
+			elementDiags.WithTitle("Unlicensed privilege").AddWarning(
+				"The organization has no license to use the privilege '%s'.", ref.ValueString())
+		}
+
+		ids = append(ids, types.StringPointerValue(privilege.Id))
+	}
 
+	return diags.SetValue(types.StringType, ids)
 }
 
 //func (r RoleResource) updateRoleState(
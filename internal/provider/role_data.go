@@ -193,19 +193,7 @@ func (d *RoleDataSource) Read(ctx context.Context, req ReadRequest, resp *ReadRe
 	}
 
 	// Handle error responses.
-	if apiRes.StatusCode() != 200 {
-		CheckApiErrorV3(diags,
-			apiRes.JSON400,
-			apiRes.JSON401,
-			apiRes.JSON403,
-			apiRes.JSON404,
-			apiRes.JSON500,
-			apiRes.JSON502,
-			apiRes.JSON503,
-		)
-		if !diags.HasError() {
-			diags.HandleError(RequireHttpStatus(&apiRes.ClientResponse, 200))
-		}
+	if diags.HandleError(apiRes.RequireStatus(200)) {
 		return
 	}
 
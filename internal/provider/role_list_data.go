@@ -2,11 +2,17 @@ package provider
 
 import (
 	"context"
+	"regexp"
+
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/common"
 	"terraform-provider-idmc/internal/idmc/v3"
+	"terraform-provider-idmc/internal/utils"
 
 	. "github.com/hashicorp/terraform-plugin-framework/datasource"
 	. "terraform-provider-idmc/internal/provider/utils"
@@ -25,7 +31,12 @@ func NewRoleListDataSource() DataSource {
 }
 
 type RoleListDataSourceModel struct {
-	Roles types.List `tfsdk:"roles"`
+	SystemRole        types.Bool   `tfsdk:"system_role"`
+	Status            types.String `tfsdk:"status"`
+	NameRegex         types.String `tfsdk:"name_regex"`
+	HasPrivilege      types.String `tfsdk:"has_privilege"`
+	IncludePrivileges types.Bool   `tfsdk:"include_privileges"`
+	Roles             types.List   `tfsdk:"roles"`
 }
 
 func (d *RoleListDataSource) Metadata(_ context.Context, req MetadataRequest, resp *MetadataResponse) {
@@ -36,6 +47,32 @@ func (d *RoleListDataSource) Schema(_ context.Context, _ SchemaRequest, resp *Sc
 	resp.Schema = schema.Schema{
 		Description: "https://docs.informatica.com/integration-cloud/data-integration/current-version/rest-api-reference/platform-rest-api-version-3-resources/roles/getting-role-details.html",
 		Attributes: map[string]schema.Attribute{
+			"system_role": schema.BoolAttribute{
+				Description: "Only include system-defined roles when true, or custom roles when false.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Only include roles with this status.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(v3.RoleStatusEnabled),
+						string(v3.RoleStatusDisabled),
+					),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only include roles with a name matching this regular expression.",
+				Optional:    true,
+			},
+			"has_privilege": schema.StringAttribute{
+				Description: "Only include roles assigned the privilege with this id or name.",
+				Optional:    true,
+			},
+			"include_privileges": schema.BoolAttribute{
+				Description: "Whether to include the privileges assigned to each role in the results.",
+				Optional:    true,
+			},
 			"roles": schema.ListNestedAttribute{
 				Description: "The query results",
 				Computed:    true,
@@ -91,6 +128,34 @@ func (d *RoleListDataSource) Schema(_ context.Context, _ SchemaRequest, resp *Sc
 							Description: "Whether the organization's license to use the role is valid or has expired.",
 							Computed:    true,
 						},
+						"privileges": schema.ListNestedAttribute{
+							Description: "The privileges assigned to the role, if include_privileges is set.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "Privilege ID.",
+										Computed:    true,
+									},
+									"name": schema.StringAttribute{
+										Description: "Name of the privilege.",
+										Computed:    true,
+									},
+									"description": schema.StringAttribute{
+										Description: "Description of the privilege.",
+										Computed:    true,
+									},
+									"service": schema.StringAttribute{
+										Description: "Service the privilege applies to.",
+										Computed:    true,
+									},
+									"status": schema.StringAttribute{
+										Description: "Status of the privilege (Enabled/Disabled).",
+										Computed:    true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -112,9 +177,63 @@ var roleListDataRoleType = types.ObjectType{
 		"updated_by":          types.StringType,
 		"created_time":        timetypes.RFC3339Type{},
 		"updated_time":        timetypes.RFC3339Type{},
+		"privileges":          types.ListType{ElemType: rolesDataRolesPrivilegeType},
 	},
 }
 
+// roleListFilter holds the filters applied to the role list, as the API can
+// only look roles up by exact id or name.
+type roleListFilter struct {
+	systemRole  *bool
+	status      string
+	namePattern *regexp.Regexp
+	privilege   string
+}
+
+func newRoleListFilter(diags DiagsHandler, config RoleListDataSourceModel) roleListFilter {
+	filter := roleListFilter{
+		systemRole: config.SystemRole.ValueBoolPointer(),
+		status:     config.Status.ValueString(),
+		privilege:  config.HasPrivilege.ValueString(),
+	}
+	if nameRegex := config.NameRegex.ValueString(); nameRegex != "" {
+		namePattern, patternErr := regexp.Compile(nameRegex)
+		if patternErr != nil {
+			diags.AtName("name_regex").AddError("Invalid regular expression: %s", patternErr.Error())
+		}
+		filter.namePattern = namePattern
+	}
+	return filter
+}
+
+// needsPrivileges reports whether the privileges of each role are needed to
+// apply the filter.
+func (f roleListFilter) needsPrivileges() bool {
+	return f.privilege != ""
+}
+
+// matches reports whether a role passes every configured filter.
+func (f roleListFilter) matches(item v3.GetRolesResponseBodyItem) bool {
+	if f.systemRole != nil && utils.Val(item.SystemRole) != *f.systemRole {
+		return false
+	}
+	if f.status != "" && utils.Val((*string)(item.Status)) != f.status {
+		return false
+	}
+	if f.namePattern != nil && !f.namePattern.MatchString(utils.Val(item.RoleName)) {
+		return false
+	}
+	if f.privilege != "" {
+		for _, privilege := range utils.ValOr(item.Privileges, nil) {
+			if utils.Val(privilege.Id) == f.privilege || utils.Val(privilege.Name) == f.privilege {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
 func (d *RoleListDataSource) Read(ctx context.Context, req ReadRequest, resp *ReadResponse) {
 	diags := NewDiagsHandler(&resp.Diagnostics, MsgDataSourceBadRead)
 	defer func() { diags.HandlePanic(recover()) }()
@@ -131,38 +250,56 @@ func (d *RoleListDataSource) Read(ctx context.Context, req ReadRequest, resp *Re
 		return
 	}
 
-	// Perform the API request.
-	apiRes, apiErr := client.GetRolesWithResponse(ctx, &v3.GetRolesParams{})
-	if diags.HandleError(apiErr) {
+	filter := newRoleListFilter(diags, config)
+	if diags.HasError() {
 		return
 	}
 
-	// Handle error responses.
-	if apiRes.StatusCode() != 200 {
-		CheckApiErrorV3(diags,
-			apiRes.JSON400,
-			apiRes.JSON401,
-			apiRes.JSON403,
-			apiRes.JSON404,
-			apiRes.JSON500,
-			apiRes.JSON502,
-			apiRes.JSON503,
-		)
-		if !diags.HasError() {
-			diags.HandleError(RequireHttpStatus(&apiRes.ClientResponse, 200))
+	// Only expand the privileges when they're asked for, as it makes for a
+	// much larger response.
+	includePrivileges := config.IncludePrivileges.ValueBool()
+	var expand *v3.GetRolesParamsExpand
+	if includePrivileges || filter.needsPrivileges() {
+		expand = utils.Ptr(v3.GetRolesParamsExpandPrivileges)
+	}
+
+	// Page through the full set of roles.
+	pages := common.NewPaginator(common.DefaultPageLimit, func(ctx context.Context, limit int32, skip int32) ([]v3.GetRolesResponseBodyItem, error) {
+		apiRes, apiErr := client.GetRolesWithResponse(ctx, &v3.GetRolesParams{
+			Expand: expand,
+			Limit:  &limit,
+			Skip:   &skip,
+		})
+		if apiErr != nil {
+			return nil, apiErr
 		}
+
+		return utils.ValOr(apiRes.JSON200, nil), apiRes.RequireStatus(200)
+	})
+
+	items, itemsErr := pages.All(ctx)
+	if diags.HandleError(itemsErr) {
 		return
 	}
 
-	config.setRoles(diags, apiRes.JSON200)
+	matched := make([]v3.GetRolesResponseBodyItem, 0, len(items))
+	for _, item := range items {
+		if filter.matches(item) {
+			matched = append(matched, item)
+		}
+	}
+
+	if config.setRoles(diags, &matched, includePrivileges) {
+		return
+	}
 
 	// Update the state and add the result
 	diags.Append(resp.State.Set(ctx, &config))
 
 }
 
-func (r *RoleListDataSourceModel) setRoles(diags DiagsHandler, items *[]v3.GetRolesResponseBodyItem) bool {
-	diags = diags.AtName("roleAttrs")
+func (r *RoleListDataSourceModel) setRoles(diags DiagsHandler, items *[]v3.GetRolesResponseBodyItem, includePrivileges bool) bool {
+	diags = diags.AtName("roles")
 
 	if items == nil {
 		diags.WithTitle("Issue reading datasource.").AddWarning(
@@ -174,6 +311,12 @@ func (r *RoleListDataSourceModel) setRoles(diags DiagsHandler, items *[]v3.GetRo
 	roleAttrs := make([]attr.Value, len(*items))
 	for index, item := range *items {
 		itemDiags := diags.AtListIndex(index)
+		privileges := types.ListNull(rolesDataRolesPrivilegeType)
+		if includePrivileges {
+			var itemModel RoleDataSourceModel
+			itemModel.setPrivileges(itemDiags, item.Privileges)
+			privileges = itemModel.Privileges
+		}
 		roleAttrs[index] = itemDiags.ObjectValue(roleListDataRoleType.AttrTypes, map[string]attr.Value{
 			"id":                  types.StringPointerValue(item.Id),
 			"name":                types.StringPointerValue(item.RoleName),
@@ -187,6 +330,7 @@ func (r *RoleListDataSourceModel) setRoles(diags DiagsHandler, items *[]v3.GetRo
 			"updated_by":          types.StringPointerValue(item.UpdatedBy),
 			"created_time":        itemDiags.AtName("created_time").TimePointer(item.CreateTime),
 			"updated_time":        itemDiags.AtName("updated_time").TimePointer(item.UpdateTime),
+			"privileges":          privileges,
 		})
 	}
 
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/v3"
+	"terraform-provider-idmc/internal/utils"
+
+	. "github.com/onsi/gomega"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+func TestRoleListFilter(t *testing.T) {
+	RegisterTestingT(t)
+
+	designer := v3.GetRolesResponseBodyItem{
+		RoleName:   utils.Ptr("Designer"),
+		SystemRole: utils.Ptr(true),
+		Status:     utils.Ptr(v3.RoleStatusEnabled),
+		Privileges: &[]v3.RolePrivilegeItem{
+			{Id: utils.Ptr("0EBevfPsSRnjOEeM9kNvMz"), Name: utils.Ptr("view.cdmp.DeliveryTargets")},
+		},
+	}
+	custom := v3.GetRolesResponseBodyItem{
+		RoleName:   utils.Ptr("test_role"),
+		SystemRole: utils.Ptr(false),
+		Status:     utils.Ptr(v3.RoleStatusDisabled),
+	}
+
+	newFilter := func(config RoleListDataSourceModel) roleListFilter {
+		var diagnostics diag.Diagnostics
+		filter := newRoleListFilter(NewDiagsHandler(&diagnostics, MsgDataSourceBadRead), config)
+		Expect(diagnostics.HasError()).To(BeFalse())
+		return filter
+	}
+
+	filter := newFilter(RoleListDataSourceModel{})
+	Expect(filter.needsPrivileges()).To(BeFalse())
+	Expect(filter.matches(designer)).To(BeTrue())
+	Expect(filter.matches(custom)).To(BeTrue())
+
+	filter = newFilter(RoleListDataSourceModel{SystemRole: types.BoolValue(false)})
+	Expect(filter.matches(designer)).To(BeFalse())
+	Expect(filter.matches(custom)).To(BeTrue())
+
+	filter = newFilter(RoleListDataSourceModel{Status: types.StringValue("Enabled")})
+	Expect(filter.matches(designer)).To(BeTrue())
+	Expect(filter.matches(custom)).To(BeFalse())
+
+	filter = newFilter(RoleListDataSourceModel{NameRegex: types.StringValue("^test_")})
+	Expect(filter.matches(designer)).To(BeFalse())
+	Expect(filter.matches(custom)).To(BeTrue())
+
+	filter = newFilter(RoleListDataSourceModel{HasPrivilege: types.StringValue("view.cdmp.DeliveryTargets")})
+	Expect(filter.needsPrivileges()).To(BeTrue())
+	Expect(filter.matches(designer)).To(BeTrue())
+	Expect(filter.matches(custom)).To(BeFalse())
+
+	filter = newFilter(RoleListDataSourceModel{HasPrivilege: types.StringValue("0EBevfPsSRnjOEeM9kNvMz")})
+	Expect(filter.matches(designer)).To(BeTrue())
+
+	var diagnostics diag.Diagnostics
+	newRoleListFilter(NewDiagsHandler(&diagnostics, MsgDataSourceBadRead), RoleListDataSourceModel{
+		NameRegex: types.StringValue("(unclosed"),
+	})
+	Expect(diagnostics.HasError()).To(BeTrue())
+
+}
@@ -3,10 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/common"
 	"terraform-provider-idmc/internal/idmc/v3"
 	"terraform-provider-idmc/internal/utils"
 
@@ -27,8 +30,12 @@ func NewRolePrivilegeListDataSource() DataSource {
 }
 
 type RolePrivilegeListDataSourceModel struct {
-	Status     types.String `tfsdk:"status"`
-	Privileges types.List   `tfsdk:"privileges"`
+	Status              types.String `tfsdk:"status"`
+	Service             types.String `tfsdk:"service"`
+	NamePrefix          types.String `tfsdk:"name_prefix"`
+	NameRegex           types.String `tfsdk:"name_regex"`
+	Privileges          types.List   `tfsdk:"privileges"`
+	PrivilegesByService types.Map    `tfsdk:"privileges_by_service"`
 }
 type RolePrivilegeListDataSourceModelPrivilege struct {
 	Id          types.String `tfsdk:"id"`
@@ -50,6 +57,23 @@ func (d *RolePrivilegeListDataSource) Schema(_ context.Context, _ SchemaRequest,
 				Description: "Filters the results by status. Use 'All' to get more than enabled and default results.",
 				Optional:    true,
 			},
+			"service": schema.StringAttribute{
+				Description: "Only include privileges of this service, such as 'Data Integration'. Matched case-insensitively.",
+				Optional:    true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Only include privileges with a name starting with this prefix.",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only include privileges with a name matching this regular expression.",
+				Optional:    true,
+			},
+			"privileges_by_service": schema.MapAttribute{
+				Description: "The names of the resulting privileges, grouped by service.",
+				Computed:    true,
+				ElementType: types.SetType{ElemType: types.StringType},
+			},
 			"privileges": schema.ListNestedAttribute{
 				Description: "The results of the privilege list request.",
 				Computed:    true,
@@ -92,6 +116,43 @@ var privilegeDataItemType = types.ObjectType{
 	},
 }
 
+// privilegeListFilter holds the filters applied to the privilege list, as the
+// API can only filter privileges by status.
+type privilegeListFilter struct {
+	service     string
+	namePrefix  string
+	namePattern *regexp.Regexp
+}
+
+func newPrivilegeListFilter(diags DiagsHandler, config RolePrivilegeListDataSourceModel) privilegeListFilter {
+	filter := privilegeListFilter{
+		service:    config.Service.ValueString(),
+		namePrefix: config.NamePrefix.ValueString(),
+	}
+	if nameRegex := config.NameRegex.ValueString(); nameRegex != "" {
+		namePattern, patternErr := regexp.Compile(nameRegex)
+		if patternErr != nil {
+			diags.AtName("name_regex").AddError("Invalid regular expression: %s", patternErr.Error())
+		}
+		filter.namePattern = namePattern
+	}
+	return filter
+}
+
+// matches reports whether a privilege passes every configured filter.
+func (f privilegeListFilter) matches(item v3.RolePrivilegeItem) bool {
+	if f.service != "" && !strings.EqualFold(utils.Val(item.Service), f.service) {
+		return false
+	}
+	if !strings.HasPrefix(utils.Val(item.Name), f.namePrefix) {
+		return false
+	}
+	if f.namePattern != nil && !f.namePattern.MatchString(utils.Val(item.Name)) {
+		return false
+	}
+	return true
+}
+
 func (d *RolePrivilegeListDataSource) Read(ctx context.Context, req ReadRequest, resp *ReadResponse) {
 	diags := NewDiagsHandler(&resp.Diagnostics, MsgDataSourceBadRead)
 	defer func() { diags.HandlePanic(recover()) }()
@@ -107,36 +168,44 @@ func (d *RolePrivilegeListDataSource) Read(ctx context.Context, req ReadRequest,
 		return
 	}
 
+	filter := newPrivilegeListFilter(diags, config)
+	if diags.HasError() {
+		return
+	}
+
 	// Obtain request parameters from config.
-	params := &v3.ListPrivilegesParams{}
+	var query *string
 	if !config.Status.IsNull() {
-		params.Q = utils.Ptr(fmt.Sprintf("status==\"%s\"", config.Status.ValueString()))
+		query = utils.Ptr(fmt.Sprintf("status==\"%s\"", config.Status.ValueString()))
 	}
 
-	// Perform the API request.
-	apiRes, apiErr := client.ListPrivilegesWithResponse(ctx, params)
-	if diags.HandleError(apiErr) {
+	// Page through the full set of privileges.
+	pages := common.NewPaginator(common.DefaultPageLimit, func(ctx context.Context, limit int32, skip int32) ([]v3.RolePrivilegeItem, error) {
+		apiRes, apiErr := client.ListPrivilegesWithResponse(ctx, &v3.ListPrivilegesParams{
+			Q:     query,
+			Limit: &limit,
+			Skip:  &skip,
+		})
+		if apiErr != nil {
+			return nil, apiErr
+		}
+
+		return utils.ValOr(apiRes.JSON200, nil), apiRes.RequireStatus(200)
+	})
+
+	items, itemsErr := pages.All(ctx)
+	if diags.HandleError(itemsErr) {
 		return
 	}
 
-	// Handle error responses.
-	if apiRes.StatusCode() != 200 {
-		CheckApiErrorV3(diags,
-			apiRes.JSON400,
-			apiRes.JSON401,
-			apiRes.JSON403,
-			apiRes.JSON404,
-			apiRes.JSON500,
-			apiRes.JSON502,
-			apiRes.JSON503,
-		)
-		if !diags.HasError() {
-			diags.HandleError(RequireHttpStatus(&apiRes.ClientResponse, 200))
+	matched := make([]v3.RolePrivilegeItem, 0, len(items))
+	for _, item := range items {
+		if filter.matches(item) {
+			matched = append(matched, item)
 		}
-		return
 	}
 
-	if config.setPrivileges(diags, apiRes.JSON200) {
+	if config.setPrivileges(diags, &matched) {
 		return
 	}
 
@@ -146,14 +215,18 @@ func (d *RolePrivilegeListDataSource) Read(ctx context.Context, req ReadRequest,
 }
 
 func (r *RolePrivilegeListDataSourceModel) setPrivileges(diags DiagsHandler, items *[]v3.RolePrivilegeItem) bool {
+	serviceDiags := diags.AtName("privileges_by_service")
 	diags = diags.AtName("privileges")
 
 	if items == nil {
 		diags.AddWarning("Expected API response to contain privilege list.")
 		r.Privileges = types.ListNull(privilegeDataItemType)
+		r.PrivilegesByService = types.MapNull(types.SetType{ElemType: types.StringType})
 		return false
 	}
 
+	byService := map[string][]attr.Value{}
+
 	privileges := make([]attr.Value, len(*items))
 	for index, item := range *items {
 		privileges[index] = diags.AtListIndex(index).ObjectValue(privilegeDataItemType.AttrTypes, map[string]attr.Value{
@@ -163,14 +236,25 @@ func (r *RolePrivilegeListDataSourceModel) setPrivileges(diags DiagsHandler, ite
 			"service":     types.StringPointerValue(item.Service),
 			"status":      types.StringPointerValue((*string)(item.Status)),
 		})
+		if item.Service != nil && item.Name != nil {
+			byService[*item.Service] = append(byService[*item.Service], types.StringValue(*item.Name))
+		}
+	}
+
+	privAttr := diags.ListValue(privilegeDataItemType, privileges)
+
+	serviceAttrs := make(map[string]attr.Value, len(byService))
+	for service, names := range byService {
+		serviceAttrs[service] = serviceDiags.AtMapKey(service).SetValue(types.StringType, names)
 	}
+	serviceAttr := serviceDiags.MapValue(types.SetType{ElemType: types.StringType}, serviceAttrs)
 
-	privAttr := diags.ListValue(rolesDataRolesPrivilegeType, privileges)
 	if diags.HasError() {
 		return true
 	}
 
 	r.Privileges = privAttr
+	r.PrivilegesByService = serviceAttr
 	return false
 
 }
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/v3"
+	"terraform-provider-idmc/internal/utils"
+
+	. "github.com/onsi/gomega"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+func TestPrivilegeListGrouping(t *testing.T) {
+	RegisterTestingT(t)
+
+	items := []v3.RolePrivilegeItem{
+		{Id: utils.Ptr("1"), Name: utils.Ptr("view.di.mapping"), Service: utils.Ptr("Data Integration")},
+		{Id: utils.Ptr("2"), Name: utils.Ptr("edit.di.mapping"), Service: utils.Ptr("Data Integration")},
+		{Id: utils.Ptr("3"), Name: utils.Ptr("view.apim.apic.asset.api"), Service: utils.Ptr("API Center")},
+	}
+
+	var diagnostics diag.Diagnostics
+	diags := NewDiagsHandler(&diagnostics, MsgDataSourceBadRead)
+	filter := newPrivilegeListFilter(diags, RolePrivilegeListDataSourceModel{
+		Service:    types.StringValue("data integration"),
+		NamePrefix: types.StringValue("view."),
+	})
+	Expect(diagnostics.HasError()).To(BeFalse())
+	Expect(filter.matches(items[0])).To(BeTrue())
+	Expect(filter.matches(items[1])).To(BeFalse())
+	Expect(filter.matches(items[2])).To(BeFalse())
+
+	filter = newPrivilegeListFilter(diags, RolePrivilegeListDataSourceModel{
+		NameRegex: types.StringValue(`\.mapping$`),
+	})
+	Expect(filter.matches(items[1])).To(BeTrue())
+	Expect(filter.matches(items[2])).To(BeFalse())
+
+	var model RolePrivilegeListDataSourceModel
+	Expect(model.setPrivileges(diags, &items)).To(BeFalse())
+	Expect(model.Privileges.Elements()).To(HaveLen(3))
+	Expect(model.PrivilegesByService.Elements()).To(HaveKeyWithValue("Data Integration",
+		types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("view.di.mapping"),
+			types.StringValue("edit.di.mapping"),
+		}),
+	))
+	Expect(model.PrivilegesByService.Elements()).To(HaveLen(2))
+
+	newPrivilegeListFilter(diags, RolePrivilegeListDataSourceModel{
+		NameRegex: types.StringValue("(unclosed"),
+	})
+	Expect(diagnostics.HasError()).To(BeTrue())
+
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc"
+	"terraform-provider-idmc/internal/idmc/v3"
+
+	. "github.com/onsi/gomega"
+	. "terraform-provider-idmc/internal/provider/utils"
+	. "terraform-provider-idmc/internal/utils"
+)
+
+var testPrivileges = []v3.RolePrivilegeItem{
+	{Id: Ptr("0EBevfPsSRnjOEeM9kNvMz"), Name: Ptr("view.cdmp.DeliveryTargets"), Status: Ptr(v3.RolePrivilegeItemStatusEnabled)},
+	{Id: Ptr("03N6cwkjyQhjbVRQEwtAMJ"), Name: Ptr("view.apim.apic.asset.api"), Status: Ptr(v3.RolePrivilegeItemStatusDefault)},
+	{Id: Ptr("5ODhYJdz7YJdKMyJGp8yHl"), Name: Ptr("create.mi.task"), Status: Ptr(v3.RolePrivilegeItemStatusDisabled)},
+	{Id: Ptr("2Cgyoa4r1hwgaLhBCKfiBP"), Name: Ptr("run.mi.task"), Status: Ptr(v3.RolePrivilegeItemStatusUnassigned)},
+	{Id: Ptr("1kVYLRkYSVOi8q9d8Oqsph"), Name: Ptr("view.duplicate"), Status: Ptr(v3.RolePrivilegeItemStatusEnabled)},
+	{Id: Ptr("6d2h3Pn4bQbhMPNk7xSmZF"), Name: Ptr("view.duplicate"), Status: Ptr(v3.RolePrivilegeItemStatusEnabled)},
+}
+
+func testPrivilegeSet(refs ...string) types.Set {
+	elements := make([]attr.Value, len(refs))
+	for index, ref := range refs {
+		elements[index] = types.StringValue(ref)
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+func TestResolvePrivilegeIds(t *testing.T) {
+	RegisterTestingT(t)
+
+	var diagnostics diag.Diagnostics
+	ids := resolvePrivilegeIds(
+		NewDiagsHandler(&diagnostics, MsgResourceBadPlan).AtName("privileges"),
+		testPrivilegeSet("view.cdmp.DeliveryTargets", "03N6cwkjyQhjbVRQEwtAMJ"),
+		testPrivileges,
+	)
+	Expect(diagnostics).To(BeEmpty())
+	Expect(ids).To(Equal(testPrivilegeSet("0EBevfPsSRnjOEeM9kNvMz", "03N6cwkjyQhjbVRQEwtAMJ")))
+
+}
+
+func TestResolvePrivilegeIdsProblems(t *testing.T) {
+	RegisterTestingT(t)
+
+	var diagnostics diag.Diagnostics
+	resolvePrivilegeIds(
+		NewDiagsHandler(&diagnostics, MsgResourceBadPlan).AtName("privileges"),
+		testPrivilegeSet("view.cdmp.DeliveryTarget", "create.mi.task", "run.mi.task", "view.duplicate"),
+		testPrivileges,
+	)
+	Expect(diagnostics.ErrorsCount()).To(Equal(3))
+	Expect(diagnostics.WarningsCount()).To(Equal(1))
+
+	// Each problem is reported against the offending value.
+	typo := path.Root("privileges").AtSetValue(types.StringValue("view.cdmp.DeliveryTarget"))
+	Expect(diagnostics.Errors()).To(ContainElement(
+		WithTransform(func(d diag.Diagnostic) path.Path {
+			return d.(diag.DiagnosticWithPath).Path()
+		}, Equal(typo)),
+	))
+
+}
+
+func TestRoleSetPrivilegesKeepsIds(t *testing.T) {
+	RegisterTestingT(t)
+
+	// When everything's referenced by id, no privilege lookup is needed.
+	var diagnostics diag.Diagnostics
+	data := RoleResourceModel{Privileges: testPrivilegeSet("0EBevfPsSRnjOEeM9kNvMz")}
+	Expect(data.setPrivileges(context.Background(), NewDiagsHandler(&diagnostics, MsgResourceBadRead), nil, &[]v3.RolePrivilegeItem{
+		{Id: Ptr("0EBevfPsSRnjOEeM9kNvMz")},
+		{Id: Ptr("03N6cwkjyQhjbVRQEwtAMJ")},
+	})).To(BeFalse())
+	Expect(diagnostics).To(BeEmpty())
+	Expect(data.Privileges).To(Equal(testPrivilegeSet("0EBevfPsSRnjOEeM9kNvMz", "03N6cwkjyQhjbVRQEwtAMJ")))
+	Expect(data.PrivilegeIds).To(Equal(data.Privileges))
+
+}
+
+func TestRoleResolveCopiedPrivileges(t *testing.T) {
+	RegisterTestingT(t)
+
+	// Serves the test privileges, and a role that's found by either its id or
+	// its name.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var body any = []any{}
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/privileges"):
+			if r.URL.Query().Get("skip") == "0" {
+				body = testPrivileges
+			}
+		case strings.HasSuffix(r.URL.Path, "/roles"):
+			query := r.URL.Query().Get("q")
+			if query == `roleId=="designerId"` || query == `roleName=="Designer"` {
+				body = []v3.GetRolesResponseBodyItem{{
+					Id:       Ptr("designerId"),
+					RoleName: Ptr("Designer"),
+					Privileges: &[]v3.RolePrivilegeItem{
+						{Id: Ptr("0EBevfPsSRnjOEeM9kNvMz")},
+						{Id: Ptr("1kVYLRkYSVOi8q9d8Oqsph")},
+					},
+				}}
+			}
+		}
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	defer server.Close()
+
+	api, apiErr := idmc.NewIdmcApi(server.URL, "session")
+	Expect(apiErr).NotTo(HaveOccurred())
+	resource := RoleResource{&IdmcProviderResource{IdmcProviderData: &IdmcProviderData{Api: api}}}
+
+	resolve := func(data *RoleResourceModel) diag.Diagnostics {
+		var diagnostics diag.Diagnostics
+		resource.resolvePrivileges(context.Background(), NewDiagsHandler(&diagnostics, MsgResourceBadPlan), data)
+		return diagnostics
+	}
+
+	// The copied role can be referenced by either name or id.
+	for _, roleRef := range []string{"Designer", "designerId"} {
+		data := RoleResourceModel{
+			CopyFromRole:    types.StringValue(roleRef),
+			AdditionalPrivs: testPrivilegeSet("view.apim.apic.asset.api"),
+			ExcludedPrivs:   testPrivilegeSet("view.cdmp.DeliveryTargets"),
+		}
+		Expect(resolve(&data)).To(BeEmpty())
+		expected := testPrivilegeSet("1kVYLRkYSVOi8q9d8Oqsph", "03N6cwkjyQhjbVRQEwtAMJ")
+		Expect(data.PrivilegeIds.Equal(expected)).To(BeTrue())
+		Expect(data.Privileges.Equal(expected)).To(BeTrue())
+	}
+
+	// Excluding everything would leave the role without any privileges.
+	data := RoleResourceModel{
+		CopyFromRole:    types.StringValue("Designer"),
+		AdditionalPrivs: types.SetNull(types.StringType),
+		ExcludedPrivs:   testPrivilegeSet("0EBevfPsSRnjOEeM9kNvMz", "1kVYLRkYSVOi8q9d8Oqsph"),
+	}
+	diagnostics := resolve(&data)
+	Expect(diagnostics.ErrorsCount()).To(Equal(1))
+	Expect(diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path()).To(Equal(path.Root("excluded_privileges")))
+
+	// Roles that can't be found are reported against copy_from_role.
+	data = RoleResourceModel{
+		CopyFromRole:    types.StringValue("Missing"),
+		AdditionalPrivs: types.SetNull(types.StringType),
+		ExcludedPrivs:   types.SetNull(types.StringType),
+	}
+	diagnostics = resolve(&data)
+	Expect(diagnostics.ErrorsCount()).To(Equal(1))
+	Expect(diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path()).To(Equal(path.Root("copy_from_role")))
+
+	// Nothing can be worked out until the copied role is known.
+	data = RoleResourceModel{
+		CopyFromRole:    types.StringUnknown(),
+		AdditionalPrivs: types.SetNull(types.StringType),
+		ExcludedPrivs:   types.SetNull(types.StringType),
+	}
+	Expect(resolve(&data)).To(BeEmpty())
+	Expect(data.PrivilegeIds.IsUnknown()).To(BeTrue())
+	Expect(data.Privileges.IsUnknown()).To(BeTrue())
+
+}
@@ -2,13 +2,18 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/common"
 	"terraform-provider-idmc/internal/idmc/v2"
 	"terraform-provider-idmc/internal/utils"
 
@@ -40,6 +45,9 @@ type RuntimeEnvironmentResourceModel struct {
 	Shared      types.Bool   `tfsdk:"shared"`
 	FederatedId types.String `tfsdk:"federated_id"`
 	Agents      types.Set    `tfsdk:"agents"`
+
+	Services          types.Set `tfsdk:"services"`
+	ServiceProperties types.Set `tfsdk:"service_properties"`
 }
 
 // TODO: Implement serverless config.
@@ -64,36 +72,54 @@ func (r RuntimeEnvironmentResource) Schema(ctx context.Context, req SchemaReques
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "Runtime environment name.",
+				Description: "Runtime environment name. Changing this renames the environment in place.",
 				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"shared": schema.BoolAttribute{
-				Description: "Indicates whether the Secure Agent group is shared.",
+				Description: "Indicates whether the Secure Agent group is shared with sub-organizations.",
 				Optional:    true,
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
 			},
 			"description": schema.StringAttribute{
-				Description: "Description of the runtime environment.",
+				Description: "Description of the runtime environment. Left as it is when not configured.",
+				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"org_id": schema.StringAttribute{
 				Description: "Organization ID.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"federated_id": schema.StringAttribute{
 				Description: "Global unique identifier.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"agents": schema.SetAttribute{
 				Description: "The agents allocated to this runtime environment.",
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"services": schema.SetAttribute{
+				Description: "Names of the services enabled on the agents of the runtime environment, such as 'Data Integration Server'. " +
+					"Left as they are when not configured.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_properties": agentConfigPropertiesAttribute(
+				"Configuration properties shared by the agents of the runtime environment. " +
+					"Only the properties declared are managed, and they're reset to their defaults when removed, " +
+					"so they shouldn't also be managed by an idmc_agent_config resource."),
 			"created_by": schema.StringAttribute{
 				Description: "User who created the runtime environment.",
 				Computed:    true,
@@ -138,6 +164,9 @@ func (r RuntimeEnvironmentResource) Create(ctx context.Context, req CreateReques
 		Name:     data.Name.ValueString(),
 		IsShared: data.Shared.ValueBoolPointer(),
 	}
+	if !data.Description.IsUnknown() {
+		reqBody.Description = data.Description.ValueStringPointer()
+	}
 
 	apiRes, apiErr := client.CreateRuntimeEnvironmentWithResponse(ctx, reqBody)
 	if diags.HandleError(apiErr) {
@@ -145,26 +174,29 @@ func (r RuntimeEnvironmentResource) Create(ctx context.Context, req CreateReques
 	}
 
 	// Handle error responses.
-	if apiRes.StatusCode() != 200 {
-		CheckApiErrorV2(diags,
-			apiRes.JSON400,
-			apiRes.JSON401,
-			apiRes.JSON403,
-			apiRes.JSON404,
-			apiRes.JSON500,
-			apiRes.JSON502,
-			apiRes.JSON503,
-		)
-		if !diags.HasError() {
-			diags.HandleError(RequireHttpStatus(&apiRes.ClientResponse, 200))
-		}
+	if diags.HandleError(apiRes.RequireStatus(200)) {
 		return
 	}
 
+	// Keep the planned services to apply once the environment exists.
+	planned := data
 	if r.updateRuntimeEnvironmentState(diags, &data, apiRes.JSON200) {
 		return
 	}
 
+	// Save what's been created in case configuring its services fails.
+	data.Services = types.SetNull(types.StringType)
+	data.ServiceProperties = types.SetNull(agentConfigPropertyType)
+	if diags.Append(resp.State.Set(ctx, &data)) {
+		return
+	}
+
+	data.Services = planned.Services
+	data.ServiceProperties = planned.ServiceProperties
+	if r.applyRuntimeEnvironmentServices(ctx, diags, client, &data, nil) {
+		return
+	}
+
 	// Save result back to state.
 	diags.Append(resp.State.Set(ctx, &data))
 
@@ -200,26 +232,13 @@ func (r RuntimeEnvironmentResource) Read(ctx context.Context, req ReadRequest, r
 		return
 	}
 
-	// Remove the resource if not found.
-	if apiRes.StatusCode() == 404 {
-		resp.State.RemoveResource(ctx)
+	// Remove the resource if not found, otherwise handle error responses.
+	resErr := apiRes.RequireStatus(200)
+	if errors.Is(resErr, common.ErrNotFound) {
+		RemoveMissingResource(ctx, diags, &resp.State, "runtime environment", data.Id.ValueString())
 		return
 	}
-
-	// Handle remaining error responses.
-	if apiRes.StatusCode() != 200 {
-		CheckApiErrorV2(diags,
-			apiRes.JSON400,
-			apiRes.JSON401,
-			apiRes.JSON403,
-			apiRes.JSON404,
-			apiRes.JSON500,
-			apiRes.JSON502,
-			apiRes.JSON503,
-		)
-		if !diags.HasError() {
-			diags.HandleError(RequireHttpStatus(&apiRes.ClientResponse, 200))
-		}
+	if diags.HandleError(resErr) {
 		return
 	}
 
@@ -227,6 +246,31 @@ func (r RuntimeEnvironmentResource) Read(ctx context.Context, req ReadRequest, r
 		return
 	}
 
+	// The services aren't available for every environment, so failing to read
+	// them only matters when they're being managed.
+	services, servicesErr := readRuntimeEnvironmentServices(ctx, client, data.Id.ValueString())
+	if servicesErr == nil {
+		data.Services = runtimeEnvironmentServicesValue(diags.AtName("services"), services)
+	} else if !data.ServiceProperties.IsNull() {
+		diags.AtName("services").HandleError(servicesErr)
+		return
+	} else {
+		diags.AtName("services").WithTitle("Unable to read runtime environment services").AddWarning(
+			"The services have been left as they were: %s", servicesErr)
+	}
+
+	if !data.ServiceProperties.IsNull() {
+		configs, configsErr := readRuntimeEnvironmentConfigs(ctx, client, data.Id.ValueString())
+		if diags.AtName("service_properties").HandleError(configsErr) {
+			return
+		}
+		data.ServiceProperties = agentConfigPropertiesValue(diags.AtName("service_properties"),
+			agentConfigProperties(ctx, diags.AtName("service_properties"), data.ServiceProperties), configs)
+	}
+	if diags.HasError() {
+		return
+	}
+
 	// Save result back to state.
 	diags.Append(resp.State.Set(ctx, &data))
 
@@ -258,21 +302,23 @@ func (r RuntimeEnvironmentResource) Update(ctx context.Context, req UpdateReques
 		return
 	}
 
-	// Convert the stored agent ids to keep things consistent.
-	agents := make([]v2.RuntimeEnvironmentAgent, len(plan.Agents.Elements()))
-	for index, element := range plan.Agents.Elements() {
+	// Send back the agents from state, as they aren't known in the plan and
+	// leaving them out would detach them from the environment.
+	agents := make([]v2.RuntimeEnvironmentAgent, len(state.Agents.Elements()))
+	for index, element := range state.Agents.Elements() {
 		if stringVal, ok := element.(types.String); ok {
 			agents[index] = v2.RuntimeEnvironmentAgent{
 				Id:    stringVal.ValueStringPointer(),
-				OrgId: plan.OrgId.ValueStringPointer(),
+				OrgId: state.OrgId.ValueStringPointer(),
 			}
 		}
 	}
 
 	reqBody := v2.UpdateRuntimeEnvironmentJSONRequestBody{
-		Name:     plan.Name.ValueString(),
-		IsShared: plan.Shared.ValueBoolPointer(),
-		Agents:   &agents,
+		Name:        plan.Name.ValueString(),
+		IsShared:    plan.Shared.ValueBoolPointer(),
+		Description: plan.Description.ValueStringPointer(),
+		Agents:      &agents,
 	}
 
 	apiRes, apiErr := client.UpdateRuntimeEnvironmentWithResponse(ctx, plan.Id.ValueString(), reqBody)
@@ -281,19 +327,7 @@ func (r RuntimeEnvironmentResource) Update(ctx context.Context, req UpdateReques
 	}
 
 	// Handle error responses.
-	if apiRes.StatusCode() != 200 {
-		CheckApiErrorV2(diags,
-			apiRes.JSON400,
-			apiRes.JSON401,
-			apiRes.JSON403,
-			apiRes.JSON404,
-			apiRes.JSON500,
-			apiRes.JSON502,
-			apiRes.JSON503,
-		)
-		if !diags.HasError() {
-			diags.HandleError(RequireHttpStatus(&apiRes.ClientResponse, 200))
-		}
+	if diags.HandleError(apiRes.RequireStatus(200)) {
 		return
 	}
 
@@ -301,6 +335,10 @@ func (r RuntimeEnvironmentResource) Update(ctx context.Context, req UpdateReques
 		return
 	}
 
+	if r.applyRuntimeEnvironmentServices(ctx, diags, client, &plan, &state) {
+		return
+	}
+
 	// Save result back to state.
 	diags.Append(resp.State.Set(ctx, &plan))
 
@@ -330,20 +368,9 @@ func (r RuntimeEnvironmentResource) Delete(ctx context.Context, req DeleteReques
 		return
 	}
 
-	// Handle error responses.
-	if apiRes.StatusCode() != 200 {
-		CheckApiErrorV2(diags,
-			apiRes.JSON400,
-			apiRes.JSON401,
-			apiRes.JSON403,
-			apiRes.JSON404,
-			apiRes.JSON500,
-			apiRes.JSON502,
-			apiRes.JSON503,
-		)
-		if !diags.HasError() {
-			diags.HandleError(RequireHttpStatus(&apiRes.ClientResponse, 200))
-		}
+	// Handle error responses, but consider it done if it's already gone.
+	resErr := apiRes.RequireStatus(200)
+	if !errors.Is(resErr, common.ErrNotFound) && diags.HandleError(resErr) {
 		return
 	}
 
@@ -401,3 +428,119 @@ func (r RuntimeEnvironmentResource) updateRuntimeEnvironmentState(
 	return diags.HasError()
 
 }
+
+// applyRuntimeEnvironmentServices enables the planned services, and updates the
+// managed service properties that have changed since the previous state.
+func (r RuntimeEnvironmentResource) applyRuntimeEnvironmentServices(
+	ctx context.Context,
+	diags DiagsHandler,
+	client *v2.ClientWithResponses,
+	plan *RuntimeEnvironmentResourceModel,
+	state *RuntimeEnvironmentResourceModel,
+) bool {
+	id := plan.Id.ValueString()
+
+	// Only send the services when they're configured, and have changed.
+	sendServices := !plan.Services.IsUnknown() && !plan.Services.IsNull() &&
+		(state == nil || !plan.Services.Equal(state.Services))
+
+	// The services aren't available for every environment, so failing to read
+	// them only matters when they're being managed.
+	servicesDiags := diags.AtName("services")
+	services, err := readRuntimeEnvironmentServices(ctx, client, id)
+	if err != nil && (sendServices || !plan.ServiceProperties.IsNull()) {
+		servicesDiags.HandleError(err)
+		return true
+	}
+
+	if err != nil {
+		servicesDiags.WithTitle("Unable to read runtime environment services").AddWarning(
+			"The services have been left as they were: %s", err)
+		if plan.Services.IsUnknown() {
+			plan.Services = types.SetNull(types.StringType)
+		}
+	} else if sendServices {
+		enabled := map[string]bool{}
+		for _, element := range plan.Services.Elements() {
+			if name, ok := element.(types.String); ok {
+				enabled[name.ValueString()] = true
+			}
+		}
+
+		available := make([]string, len(services))
+		for index := range services {
+			available[index] = services[index].Name
+			services[index].Enabled = enabled[services[index].Name]
+			delete(enabled, services[index].Name)
+		}
+		for name := range enabled {
+			servicesDiags.AddError("The runtime environment has no service named %q. Available services are: %s.",
+				name, strings.Join(available, ", "))
+		}
+		if diags.HasError() {
+			return true
+		}
+
+		apiRes, apiErr := client.UpdateRuntimeEnvironmentServicesWithResponse(ctx, id, v2.RuntimeEnvironmentServiceList{
+			Services: services,
+		})
+		if servicesDiags.HandleError(apiErr) || servicesDiags.HandleError(apiRes.RequireStatus(200)) {
+			return true
+		}
+		if apiRes.JSON200 == nil {
+			servicesDiags.AddError("no runtime environment services response data provided")
+			return true
+		}
+		services = apiRes.JSON200.Services
+	}
+	if err == nil {
+		plan.Services = runtimeEnvironmentServicesValue(servicesDiags, services)
+	}
+
+	propertiesDiags := diags.AtName("service_properties")
+	planned := agentConfigProperties(ctx, propertiesDiags, plan.ServiceProperties)
+	var previous []AgentConfigPropertyModel
+	if state != nil {
+		previous = agentConfigProperties(ctx, propertiesDiags, state.ServiceProperties)
+	}
+	if diags.HasError() {
+		return true
+	}
+
+	if updates := agentConfigUpdates(planned, previous); len(updates) > 0 {
+		configs, err := writeRuntimeEnvironmentConfigs(ctx, client, id, updates)
+		if propertiesDiags.HandleError(err) {
+			return true
+		}
+		if !plan.ServiceProperties.IsNull() {
+			plan.ServiceProperties = agentConfigPropertiesValue(propertiesDiags, planned, configs)
+		}
+	}
+
+	return diags.HasError()
+}
+
+func readRuntimeEnvironmentServices(ctx context.Context, client *v2.ClientWithResponses, id string) ([]v2.RuntimeEnvironmentService, error) {
+	apiRes, apiErr := client.GetRuntimeEnvironmentServicesWithResponse(ctx, id)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	if resErr := apiRes.RequireStatus(200); resErr != nil {
+		return nil, resErr
+	}
+	if apiRes.JSON200 == nil {
+		return nil, fmt.Errorf("no runtime environment services response data provided")
+	}
+	return apiRes.JSON200.Services, nil
+}
+
+// runtimeEnvironmentServicesValue lists the names of the enabled services.
+func runtimeEnvironmentServicesValue(diags DiagsHandler, services []v2.RuntimeEnvironmentService) types.Set {
+	var elements []attr.Value
+	for _, service := range services {
+		if service.Enabled {
+			elements = append(elements, types.StringValue(service.Name))
+		}
+	}
+	return diags.SetValue(types.StringType, elements)
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc"
+	"terraform-provider-idmc/internal/idmc/v2"
+	"terraform-provider-idmc/internal/utils"
+
+	. "github.com/onsi/gomega"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+func TestRuntimeEnvironmentServicesValue(t *testing.T) {
+	RegisterTestingT(t)
+
+	var diagnostics diag.Diagnostics
+	value := runtimeEnvironmentServicesValue(NewDiagsHandler(&diagnostics, MsgResourceBadRead), []v2.RuntimeEnvironmentService{
+		{Name: "Data Integration Server", Enabled: true},
+		{Name: "Mass Ingestion", Enabled: false},
+		{Name: "Process Server", DisplayName: utils.Ptr("Process Server"), Enabled: true},
+	})
+	Expect(diagnostics).To(BeEmpty())
+	Expect(value.Equal(testPrivilegeSet("Data Integration Server", "Process Server"))).To(BeTrue())
+
+}
+
+// testRuntimeEnvironmentServer serves the services of a runtime environment,
+// recording any that are sent back. The services can't be read if broken.
+func testRuntimeEnvironmentServer(broken bool, sent *[]v2.RuntimeEnvironmentService) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/selectedServices") || broken {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		services := v2.RuntimeEnvironmentServiceList{Services: []v2.RuntimeEnvironmentService{
+			{Name: "Data Integration Server", Enabled: true},
+			{Name: "Mass Ingestion", Enabled: false},
+		}}
+		if r.Method == http.MethodPost {
+			_ = json.NewDecoder(r.Body).Decode(&services)
+			*sent = services.Services
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(services)
+	}))
+}
+
+func TestApplyRuntimeEnvironmentServices(t *testing.T) {
+	RegisterTestingT(t)
+
+	apply := func(broken bool, plan *RuntimeEnvironmentResourceModel, state *RuntimeEnvironmentResourceModel) ([]v2.RuntimeEnvironmentService, diag.Diagnostics) {
+		var sent []v2.RuntimeEnvironmentService
+		server := testRuntimeEnvironmentServer(broken, &sent)
+		defer server.Close()
+
+		api, apiErr := idmc.NewIdmcApi(server.URL, "session")
+		Expect(apiErr).NotTo(HaveOccurred())
+
+		var diagnostics diag.Diagnostics
+		RuntimeEnvironmentResource{}.applyRuntimeEnvironmentServices(context.Background(),
+			NewDiagsHandler(&diagnostics, MsgResourceBadUpdate), api.V2.Client, plan, state)
+		return sent, diagnostics
+	}
+	newPlan := func(services types.Set) *RuntimeEnvironmentResourceModel {
+		return &RuntimeEnvironmentResourceModel{
+			Id:                types.StringValue("runtimeEnvironmentId"),
+			Services:          services,
+			ServiceProperties: types.SetNull(agentConfigPropertyType),
+		}
+	}
+
+	// Configured services are switched on, and the rest off.
+	plan := newPlan(testPrivilegeSet("Mass Ingestion"))
+	sent, diagnostics := apply(false, plan, nil)
+	Expect(diagnostics).To(BeEmpty())
+	Expect(sent).To(ConsistOf(
+		v2.RuntimeEnvironmentService{Name: "Data Integration Server", Enabled: false},
+		v2.RuntimeEnvironmentService{Name: "Mass Ingestion", Enabled: true},
+	))
+	Expect(plan.Services.Equal(testPrivilegeSet("Mass Ingestion"))).To(BeTrue())
+
+	// Unchanged services aren't sent again.
+	plan = newPlan(testPrivilegeSet("Data Integration Server"))
+	sent, diagnostics = apply(false, plan, newPlan(testPrivilegeSet("Data Integration Server")))
+	Expect(diagnostics).To(BeEmpty())
+	Expect(sent).To(BeNil())
+
+	// Services the environment doesn't have are reported.
+	sent, diagnostics = apply(false, newPlan(testPrivilegeSet("Missing")), nil)
+	Expect(diagnostics.ErrorsCount()).To(Equal(1))
+	Expect(sent).To(BeNil())
+
+	// Unconfigured services are computed, if they can be read at all.
+	plan = newPlan(types.SetUnknown(types.StringType))
+	_, diagnostics = apply(false, plan, nil)
+	Expect(diagnostics).To(BeEmpty())
+	Expect(plan.Services.Equal(testPrivilegeSet("Data Integration Server"))).To(BeTrue())
+
+	plan = newPlan(types.SetUnknown(types.StringType))
+	_, diagnostics = apply(true, plan, nil)
+	Expect(diagnostics.ErrorsCount()).To(Equal(0))
+	Expect(diagnostics.WarningsCount()).To(Equal(1))
+	Expect(plan.Services.IsNull()).To(BeTrue())
+
+	// But failing to read them is an error when they're configured.
+	_, diagnostics = apply(true, newPlan(testPrivilegeSet("Mass Ingestion")), nil)
+	Expect(diagnostics.ErrorsCount()).To(Equal(1))
+
+}
@@ -0,0 +1,391 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/common"
+	"terraform-provider-idmc/internal/idmc/v3"
+
+	. "github.com/hashicorp/terraform-plugin-framework/resource"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+var _ ResourceWithConfigure = &SamlConfigResource{}
+var _ ResourceWithValidateConfig = &SamlConfigResource{}
+
+type SamlConfigResource struct {
+	*IdmcProviderResource
+}
+
+func NewSamlConfigResource() Resource {
+	return &SamlConfigResource{
+		&IdmcProviderResource{},
+	}
+}
+
+type SamlConfigResourceModel struct {
+	IdpIssuer       types.String `tfsdk:"idp_issuer"`
+	IdpSsoUrl       types.String `tfsdk:"idp_sso_url"`
+	IdpCertificate  types.String `tfsdk:"idp_certificate"`
+	NameAttribute   types.String `tfsdk:"name_attribute"`
+	EmailAttribute  types.String `tfsdk:"email_attribute"`
+	GroupsAttribute types.String `tfsdk:"groups_attribute"`
+	RoleMappings    types.Map    `tfsdk:"role_mappings"`
+}
+
+var samlRoleMappingType = types.SetType{
+	ElemType: types.StringType,
+}
+
+// Metadata <editor-fold desc="Metadata" defaultstate="collapsed">
+func (r SamlConfigResource) Metadata(ctx context.Context, req MetadataRequest, resp *MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_saml_config"
+}
+
+// </editor-fold>
+
+// Schema <editor-fold desc="Schema" defaultstate="collapsed">
+func (r SamlConfigResource) Schema(ctx context.Context, req SchemaRequest, resp *SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The SAML single sign-on configuration of the organization the provider is logged in to. " +
+			"Only one of these should be declared per organization, and destroying it disables single sign-on. " +
+			"https://docs.informatica.com/integration-cloud/data-integration/current-version/rest-api-reference/platform-rest-api-version-3-resources/saml-single-sign-on.html",
+		Attributes: map[string]schema.Attribute{
+			"idp_issuer": schema.StringAttribute{
+				Description: "Entity ID of the identity provider.",
+				Required:    true,
+			},
+			"idp_sso_url": schema.StringAttribute{
+				Description: "URL of the identity provider's single sign-on service.",
+				Required:    true,
+			},
+			"idp_certificate": schema.StringAttribute{
+				Description: "Certificate the identity provider signs assertions with, either PEM or base64 encoded DER.",
+				Required:    true,
+			},
+			"name_attribute": schema.StringAttribute{
+				Description: "Assertion attribute holding the user's name.",
+				Optional:    true,
+			},
+			"email_attribute": schema.StringAttribute{
+				Description: "Assertion attribute holding the user's email address.",
+				Optional:    true,
+			},
+			"groups_attribute": schema.StringAttribute{
+				Description: "Assertion attribute holding the groups the user belongs to.",
+				Optional:    true,
+			},
+			"role_mappings": schema.MapAttribute{
+				Description: "Names of the IDMC roles granted to members of each identity provider group, keyed by group name.",
+				Optional:    true,
+				ElementType: samlRoleMappingType,
+			},
+		},
+	}
+}
+
+// </editor-fold>
+
+// ValidateConfig <editor-fold desc="ValidateConfig" defaultstate="collapsed">
+func (r SamlConfigResource) ValidateConfig(ctx context.Context, req ValidateConfigRequest, resp *ValidateConfigResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadConfig)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	var config SamlConfigResourceModel
+	if diags.Append(req.Config.Get(ctx, &config)) {
+		return
+	}
+
+	// Catch bad certificates before they break everyone's login.
+	if config.IdpCertificate.IsUnknown() || config.IdpCertificate.IsNull() {
+		return
+	}
+	if _, err := parseSamlCertificate(config.IdpCertificate.ValueString()); err != nil {
+		diags.AtName("idp_certificate").WithTitle("Invalid identity provider certificate").AddError("%s", err)
+	}
+
+}
+
+// </editor-fold>
+
+// Create <editor-fold desc="Create" defaultstate="collapsed">
+func (r SamlConfigResource) Create(ctx context.Context, req CreateRequest, resp *CreateResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadCreate)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV3(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load configuration from plan.
+	var plan SamlConfigResourceModel
+	if diags.Append(req.Plan.Get(ctx, &plan)) {
+		return
+	}
+
+	// Don't push a partial role mapping list, as it would drop role grants.
+	samlConfig := plan.newSamlConfig(diags)
+	if diags.HasError() {
+		return
+	}
+
+	apiRes, apiErr := client.UpdateSamlConfigWithResponse(ctx, &v3.UpdateSamlConfigParams{}, samlConfig)
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Handle error responses.
+	if diags.HandleError(apiRes.RequireStatus(200)) {
+		return
+	}
+
+	if plan.updateState(diags, apiRes.JSON200) {
+		return
+	}
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &plan))
+
+}
+
+// </editor-fold>
+
+// Read <editor-fold desc="Read" defaultstate="collapsed">
+func (r SamlConfigResource) Read(ctx context.Context, req ReadRequest, resp *ReadResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadRead)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV3(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load the previous state.
+	var data SamlConfigResourceModel
+	if diags.Append(req.State.Get(ctx, &data)) {
+		return
+	}
+
+	apiRes, apiErr := client.GetSamlConfigWithResponse(ctx, &v3.GetSamlConfigParams{})
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Remove the resource if single sign-on has been switched off elsewhere.
+	resErr := apiRes.RequireStatus(200)
+	if errors.Is(resErr, common.ErrNotFound) {
+		RemoveMissingResource(ctx, diags, &resp.State, "SAML configuration", "saml")
+		return
+	}
+	if diags.HandleError(resErr) {
+		return
+	}
+
+	if data.updateState(diags, apiRes.JSON200) {
+		return
+	}
+
+	// Save updated data into Terraform state.
+	diags.Append(resp.State.Set(ctx, &data))
+
+}
+
+// </editor-fold>
+
+// Update <editor-fold desc="Update" defaultstate="collapsed">
+func (r SamlConfigResource) Update(ctx context.Context, req UpdateRequest, resp *UpdateResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadUpdate)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV3(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load configuration from plan.
+	var plan SamlConfigResourceModel
+	if diags.Append(req.Plan.Get(ctx, &plan)) {
+		return
+	}
+
+	// Don't push a partial role mapping list, as it would drop role grants.
+	samlConfig := plan.newSamlConfig(diags)
+	if diags.HasError() {
+		return
+	}
+
+	apiRes, apiErr := client.UpdateSamlConfigWithResponse(ctx, &v3.UpdateSamlConfigParams{}, samlConfig)
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Handle error responses.
+	if diags.HandleError(apiRes.RequireStatus(200)) {
+		return
+	}
+
+	if plan.updateState(diags, apiRes.JSON200) {
+		return
+	}
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &plan))
+
+}
+
+// </editor-fold>
+
+// Delete <editor-fold desc="Delete" defaultstate="collapsed">
+func (r SamlConfigResource) Delete(ctx context.Context, req DeleteRequest, resp *DeleteResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadDelete)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV3(diags)
+	if diags.HasError() {
+		return
+	}
+
+	apiRes, apiErr := client.DeleteSamlConfigWithResponse(ctx, &v3.DeleteSamlConfigParams{})
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Handle error responses, but consider it done if it's already gone.
+	resErr := apiRes.RequireStatus(200, 204)
+	if !errors.Is(resErr, common.ErrNotFound) && diags.HandleError(resErr) {
+		return
+	}
+
+}
+
+// </editor-fold>
+
+func (m *SamlConfigResourceModel) newSamlConfig(diags DiagsHandler) v3.SamlConfig {
+	// The role mappings replace those of the organization, so anything not yet
+	// known is an error rather than something to leave out.
+	mappingsDiags := diags.AtName("role_mappings")
+	if m.RoleMappings.IsUnknown() {
+		mappingsDiags.AddError("The role mappings must be known before the SAML configuration can be applied.")
+	}
+
+	roleMappings := []v3.SamlRoleMapping{}
+	for group, value := range m.RoleMappings.Elements() {
+		groupDiags := mappingsDiags.AtMapKey(group)
+		rolesValue, ok := value.(types.Set)
+		if !ok || rolesValue.IsNull() || rolesValue.IsUnknown() {
+			groupDiags.AddError("Expected a known set of role names.")
+			continue
+		}
+		roles := make([]string, 0, len(rolesValue.Elements()))
+		for _, role := range rolesValue.Elements() {
+			roleName, ok := role.(types.String)
+			if !ok || roleName.IsNull() || roleName.IsUnknown() {
+				groupDiags.AtSetValue(role).AddError("Expected a known role name.")
+				continue
+			}
+			roles = append(roles, roleName.ValueString())
+		}
+		roleMappings = append(roleMappings, v3.SamlRoleMapping{
+			IdpGroup: group,
+			Roles:    roles,
+		})
+	}
+
+	return v3.SamlConfig{
+		IdpIssuer:      m.IdpIssuer.ValueString(),
+		IdpSsoUrl:      m.IdpSsoUrl.ValueString(),
+		IdpCertificate: m.IdpCertificate.ValueString(),
+		AttributeMappings: &v3.SamlAttributeMappings{
+			UserName: m.NameAttribute.ValueStringPointer(),
+			Email:    m.EmailAttribute.ValueStringPointer(),
+			Groups:   m.GroupsAttribute.ValueStringPointer(),
+		},
+		RoleMappings: &roleMappings,
+	}
+}
+
+func (m *SamlConfigResourceModel) updateState(diags DiagsHandler, data *v3.SamlConfig) bool {
+	if data == nil {
+		diags.AddError("no SAML configuration response data provided")
+		return true
+	}
+
+	m.IdpIssuer = types.StringValue(data.IdpIssuer)
+	m.IdpSsoUrl = types.StringValue(data.IdpSsoUrl)
+
+	// The api may re-encode the certificate, so it's only replaced when it's
+	// actually a different one.
+	if !sameSamlCertificate(m.IdpCertificate.ValueString(), data.IdpCertificate) {
+		m.IdpCertificate = types.StringValue(data.IdpCertificate)
+	}
+
+	if mappings := data.AttributeMappings; mappings != nil {
+		m.NameAttribute = OptionalStringValue(mappings.UserName)
+		m.EmailAttribute = OptionalStringValue(mappings.Email)
+		m.GroupsAttribute = OptionalStringValue(mappings.Groups)
+	} else {
+		m.NameAttribute = types.StringNull()
+		m.EmailAttribute = types.StringNull()
+		m.GroupsAttribute = types.StringNull()
+	}
+
+	// Keep an unset mapping unset, rather than showing it as empty.
+	if data.RoleMappings == nil || len(*data.RoleMappings) == 0 {
+		if len(m.RoleMappings.Elements()) > 0 {
+			m.RoleMappings = types.MapNull(samlRoleMappingType)
+		}
+		return false
+	}
+
+	mappingsDiags := diags.AtName("role_mappings")
+	roleMappings := make(map[string]attr.Value, len(*data.RoleMappings))
+	for _, mapping := range *data.RoleMappings {
+		roles := make([]attr.Value, len(mapping.Roles))
+		for index, role := range mapping.Roles {
+			roles[index] = types.StringValue(role)
+		}
+		roleMappings[mapping.IdpGroup] = mappingsDiags.AtMapKey(mapping.IdpGroup).SetValue(types.StringType, roles)
+	}
+	m.RoleMappings = mappingsDiags.MapValue(samlRoleMappingType, roleMappings)
+
+	return diags.HasError()
+}
+
+// parseSamlCertificate accepts a certificate as either PEM, or the bare base64
+// encoded DER identity providers usually put in their metadata.
+func parseSamlCertificate(text string) (*x509.Certificate, error) {
+	var der []byte
+	if block, _ := pem.Decode([]byte(text)); block != nil {
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("expected a CERTIFICATE PEM block, not %q", block.Type)
+		}
+		der = block.Bytes
+	} else {
+		decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(text), ""))
+		if err != nil {
+			return nil, fmt.Errorf("certificate is neither PEM nor base64 encoded: %w", err)
+		}
+		der = decoded
+	}
+	return x509.ParseCertificate(der)
+}
+
+func sameSamlCertificate(left string, right string) bool {
+	leftCert, leftErr := parseSamlCertificate(left)
+	rightCert, rightErr := parseSamlCertificate(right)
+	if leftErr != nil || rightErr != nil {
+		return left == right
+	}
+	return bytes.Equal(leftCert.Raw, rightCert.Raw)
+}
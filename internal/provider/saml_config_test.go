@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/utils"
+
+	. "github.com/onsi/gomega"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+func TestParseSamlCertificate(t *testing.T) {
+	RegisterTestingT(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}, &x509.Certificate{SerialNumber: big.NewInt(1)}, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+
+	pemText := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	base64Text := base64.StdEncoding.EncodeToString(der)
+	wrappedText := base64Text[:40] + "\n  " + base64Text[40:]
+
+	for _, text := range []string{pemText, base64Text, wrappedText} {
+		cert, err := parseSamlCertificate(text)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert.Subject.CommonName).To(Equal("idp.example.com"))
+	}
+
+	Expect(sameSamlCertificate(pemText, base64Text)).To(BeTrue())
+	Expect(sameSamlCertificate(pemText, "garbage")).To(BeFalse())
+
+	_, err = parseSamlCertificate("not a certificate")
+	Expect(err).To(HaveOccurred())
+
+	_, err = parseSamlCertificate(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})))
+	Expect(err).To(MatchError(ContainSubstring("CERTIFICATE")))
+
+}
+
+func TestParseSamlSpMetadata(t *testing.T) {
+	RegisterTestingT(t)
+
+	metadata, err := parseSamlSpMetadata([]byte(`<?xml version="1.0"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://dm-us.informaticacloud.com/ma">
+  <md:SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://dm-us.informaticacloud.com/ma/sso/acs" index="0"/>
+  </md:SPSSODescriptor>
+</md:EntityDescriptor>`))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(metadata.EntityId).To(Equal("https://dm-us.informaticacloud.com/ma"))
+	Expect(metadata.SpSsoDescriptor.AssertionConsumerServices).To(HaveLen(1))
+	Expect(metadata.SpSsoDescriptor.AssertionConsumerServices[0].Location).To(Equal("https://dm-us.informaticacloud.com/ma/sso/acs"))
+
+	_, err = parseSamlSpMetadata([]byte(`<html>nope</html>`))
+	Expect(err).To(HaveOccurred())
+
+}
+
+func TestNewSamlConfigRoleMappings(t *testing.T) {
+	RegisterTestingT(t)
+
+	roleSet := func(roles ...attr.Value) types.Set {
+		return types.SetValueMust(types.StringType, roles)
+	}
+	newSamlConfig := func(roleMappings types.Map) diag.Diagnostics {
+		var diagnostics diag.Diagnostics
+		m := SamlConfigResourceModel{RoleMappings: roleMappings}
+		m.newSamlConfig(NewDiagsHandler(&diagnostics, MsgResourceBadUpdate))
+		return diagnostics
+	}
+	setType := types.SetType{ElemType: types.StringType}
+
+	// Known mappings are sent as they are.
+	var diagnostics diag.Diagnostics
+	m := SamlConfigResourceModel{RoleMappings: types.MapValueMust(setType, map[string]attr.Value{
+		"admins": roleSet(types.StringValue("Admin")),
+	})}
+	config := m.newSamlConfig(NewDiagsHandler(&diagnostics, MsgResourceBadUpdate))
+	Expect(diagnostics.HasError()).To(BeFalse())
+	Expect(utils.Val(config.RoleMappings)).To(HaveLen(1))
+	Expect((*config.RoleMappings)[0].IdpGroup).To(Equal("admins"))
+	Expect((*config.RoleMappings)[0].Roles).To(Equal([]string{"Admin"}))
+
+	// But anything unknown would replace the org's role grants, so is refused.
+	Expect(newSamlConfig(types.MapUnknown(setType)).HasError()).To(BeTrue())
+	Expect(newSamlConfig(types.MapValueMust(setType, map[string]attr.Value{
+		"admins": types.SetUnknown(types.StringType),
+	})).HasError()).To(BeTrue())
+	Expect(newSamlConfig(types.MapValueMust(setType, map[string]attr.Value{
+		"admins": roleSet(types.StringValue("Admin"), types.StringUnknown()),
+	})).HasError()).To(BeTrue())
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/v3"
+
+	. "github.com/hashicorp/terraform-plugin-framework/datasource"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+var _ DataSourceWithConfigure = &SamlSpMetadataDataSource{}
+
+type SamlSpMetadataDataSource struct {
+	*IdmcProviderDataSource
+}
+
+func NewSamlSpMetadataDataSource() DataSource {
+	return &SamlSpMetadataDataSource{
+		&IdmcProviderDataSource{},
+	}
+}
+
+type SamlSpMetadataDataSourceModel struct {
+	MetadataXml types.String `tfsdk:"metadata_xml"`
+	EntityId    types.String `tfsdk:"entity_id"`
+	AcsUrl      types.String `tfsdk:"acs_url"`
+}
+
+func (d *SamlSpMetadataDataSource) Metadata(_ context.Context, req MetadataRequest, resp *MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_saml_sp_metadata"
+}
+
+func (d *SamlSpMetadataDataSource) Schema(_ context.Context, _ SchemaRequest, resp *SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The SAML service provider metadata of the organization, to register it with the identity provider. " +
+			"https://docs.informatica.com/integration-cloud/data-integration/current-version/rest-api-reference/platform-rest-api-version-3-resources/saml-single-sign-on.html",
+		Attributes: map[string]schema.Attribute{
+			"metadata_xml": schema.StringAttribute{
+				Description: "The full service provider metadata document.",
+				Computed:    true,
+			},
+			"entity_id": schema.StringAttribute{
+				Description: "Entity ID of the service provider.",
+				Computed:    true,
+			},
+			"acs_url": schema.StringAttribute{
+				Description: "URL of the service provider's assertion consumer service.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *SamlSpMetadataDataSource) Read(ctx context.Context, req ReadRequest, resp *ReadResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgDataSourceBadRead)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := d.GetApiClientV3(diags)
+	if diags.HasError() {
+		return
+	}
+
+	apiRes, apiErr := client.GetSamlSpMetadataWithResponse(ctx, &v3.GetSamlSpMetadataParams{})
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	if diags.HandleError(apiRes.RequireStatus(200)) {
+		return
+	}
+
+	metadata, metadataErr := parseSamlSpMetadata(apiRes.Body)
+	if diags.HandleError(metadataErr) {
+		return
+	}
+
+	var config SamlSpMetadataDataSourceModel
+	config.MetadataXml = types.StringValue(string(apiRes.Body))
+	config.EntityId = types.StringValue(metadata.EntityId)
+	config.AcsUrl = types.StringNull()
+	if services := metadata.SpSsoDescriptor.AssertionConsumerServices; len(services) > 0 {
+		config.AcsUrl = types.StringValue(services[0].Location)
+	}
+
+	// Update the state and add the result
+	diags.Append(resp.State.Set(ctx, &config))
+
+}
+
+// samlSpMetadata picks out the parts of a SAML EntityDescriptor that people
+// most often need to copy into their identity provider.
+type samlSpMetadata struct {
+	EntityId        string `xml:"entityID,attr"`
+	SpSsoDescriptor struct {
+		AssertionConsumerServices []struct {
+			Location string `xml:"Location,attr"`
+		} `xml:"AssertionConsumerService"`
+	} `xml:"SPSSODescriptor"`
+}
+
+func parseSamlSpMetadata(body []byte) (*samlSpMetadata, error) {
+	var metadata samlSpMetadata
+	if err := xml.Unmarshal(body, &metadata); err != nil {
+		return nil, fmt.Errorf("unable to parse service provider metadata: %w", err)
+	}
+	if metadata.EntityId == "" {
+		return nil, fmt.Errorf("service provider metadata has no entity ID")
+	}
+	return &metadata, nil
+}
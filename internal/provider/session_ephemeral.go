@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	. "github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+var _ EphemeralResourceWithConfigure = &SessionEphemeralResource{}
+var _ EphemeralResourceWithClose = &SessionEphemeralResource{}
+
+// sessionPrivateKey is where the session is stashed between Open and Close.
+const sessionPrivateKey = "session"
+
+type SessionEphemeralResource struct {
+	*IdmcProviderEphemeralResource
+}
+
+func NewSessionEphemeralResource() EphemeralResource {
+	return &SessionEphemeralResource{
+		&IdmcProviderEphemeralResource{},
+	}
+}
+
+type SessionEphemeralResourceModel struct {
+	SessionId  types.String `tfsdk:"session_id"`
+	BaseApiUrl types.String `tfsdk:"base_api_url"`
+}
+
+func (r *SessionEphemeralResource) Metadata(_ context.Context, req MetadataRequest, resp *MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session"
+}
+
+func (r *SessionEphemeralResource) Schema(_ context.Context, _ SchemaRequest, resp *SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A short-lived IDMC session, logged in with the provider credentials, for use by other " +
+			"tools that call the IDMC apis directly. The session is never stored in plan or state, and is " +
+			"logged out once terraform no longer needs it.",
+		Attributes: map[string]schema.Attribute{
+			"session_id": schema.StringAttribute{
+				Description: "The session id, to be sent in the 'INFA-SESSION-ID' (v3) or 'icSessionId' (v2) header.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"base_api_url": schema.StringAttribute{
+				Description: "The base url of the pod hosting the org, which all api paths are relative to.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *SessionEphemeralResource) Open(ctx context.Context, req OpenRequest, resp *OpenResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgEphemeralResourceBadOpen)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	session := r.NewSession(ctx, diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Keep hold of the session so it can be logged out on close.
+	sessionJson, sessionErr := json.Marshal(session)
+	if diags.HandleError(sessionErr) {
+		return
+	}
+	if diags.Append(resp.Private.SetKey(ctx, sessionPrivateKey, sessionJson)) {
+		return
+	}
+
+	diags.Append(resp.Result.Set(ctx, &SessionEphemeralResourceModel{
+		SessionId:  types.StringValue(session.SessionId),
+		BaseApiUrl: types.StringValue(session.BaseApiUrl),
+	}))
+
+}
+
+func (r *SessionEphemeralResource) Close(ctx context.Context, req CloseRequest, resp *CloseResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgEphemeralResourceBadClose)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	sessionJson, sessionDiags := req.Private.GetKey(ctx, sessionPrivateKey)
+	if diags.Append(sessionDiags) || sessionJson == nil {
+		return
+	}
+
+	var session IdmcSession
+	if diags.HandleError(json.Unmarshal(sessionJson, &session)) {
+		return
+	}
+
+	r.EndSession(ctx, diags, session)
+
+}
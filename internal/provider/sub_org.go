@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/common"
+	"terraform-provider-idmc/internal/idmc/v2"
+	"terraform-provider-idmc/internal/utils"
+
+	. "github.com/hashicorp/terraform-plugin-framework/resource"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+var _ ResourceWithConfigure = &SubOrgResource{}
+
+type SubOrgResource struct {
+	*IdmcProviderResource
+}
+
+func NewSubOrgResource() Resource {
+	return &SubOrgResource{
+		&IdmcProviderResource{},
+	}
+}
+
+type SubOrgResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	ParentOrgId      types.String `tfsdk:"parent_org_id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	InheritLicenses  types.Bool   `tfsdk:"inherit_licenses"`
+	Address1         types.String `tfsdk:"address1"`
+	Address2         types.String `tfsdk:"address2"`
+	City             types.String `tfsdk:"city"`
+	State            types.String `tfsdk:"state"`
+	Zipcode          types.String `tfsdk:"zipcode"`
+	Country          types.String `tfsdk:"country"`
+	Timezone         types.String `tfsdk:"timezone"`
+	ContactFirstName types.String `tfsdk:"contact_first_name"`
+	ContactLastName  types.String `tfsdk:"contact_last_name"`
+	ContactEmail     types.String `tfsdk:"contact_email"`
+	ContactPhone     types.String `tfsdk:"contact_phone"`
+	CreatedTime      types.String `tfsdk:"created_time"`
+	UpdatedTime      types.String `tfsdk:"updated_time"`
+	CreatedBy        types.String `tfsdk:"created_by"`
+	UpdatedBy        types.String `tfsdk:"updated_by"`
+}
+
+// Metadata <editor-fold desc="Metadata" defaultstate="collapsed">
+func (r SubOrgResource) Metadata(ctx context.Context, req MetadataRequest, resp *MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sub_org"
+}
+
+// </editor-fold>
+
+// Schema <editor-fold desc="Schema" defaultstate="collapsed">
+func (r SubOrgResource) Schema(ctx context.Context, req SchemaRequest, resp *SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A sub-organization of the organization the provider is logged in to. " +
+			"https://docs.informatica.com/integration-cloud/data-integration/current-version/rest-api-reference/platform-rest-api-version-2-resources/org.html",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Sub-organization ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"parent_org_id": schema.StringAttribute{
+				Description: "ID of the parent organization.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the sub-organization.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the sub-organization.",
+				Optional:    true,
+			},
+			"inherit_licenses": schema.BoolAttribute{
+				Description: "Whether the sub-organization inherits the licenses of its parent. Can only be set on creation.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"address1": schema.StringAttribute{
+				Description: "First line of the sub-organization's address.",
+				Optional:    true,
+			},
+			"address2": schema.StringAttribute{
+				Description: "Second line of the sub-organization's address.",
+				Optional:    true,
+			},
+			"city": schema.StringAttribute{
+				Description: "City of the sub-organization's address.",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the sub-organization's address.",
+				Optional:    true,
+			},
+			"zipcode": schema.StringAttribute{
+				Description: "Postal code of the sub-organization's address.",
+				Optional:    true,
+			},
+			"country": schema.StringAttribute{
+				Description: "Country of the sub-organization's address.",
+				Optional:    true,
+			},
+			"timezone": schema.StringAttribute{
+				Description: "Default time zone of the sub-organization. Defaults to that of the parent.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"contact_first_name": schema.StringAttribute{
+				Description: "First name of the sub-organization's contact.",
+				Optional:    true,
+			},
+			"contact_last_name": schema.StringAttribute{
+				Description: "Last name of the sub-organization's contact.",
+				Optional:    true,
+			},
+			"contact_email": schema.StringAttribute{
+				Description: "Email address of the sub-organization's contact.",
+				Optional:    true,
+			},
+			"contact_phone": schema.StringAttribute{
+				Description: "Phone number of the sub-organization's contact.",
+				Optional:    true,
+			},
+			"created_by": schema.StringAttribute{
+				Description: "User who created the sub-organization.",
+				Computed:    true,
+			},
+			"updated_by": schema.StringAttribute{
+				Description: "User who last updated the sub-organization.",
+				Computed:    true,
+			},
+			"created_time": schema.StringAttribute{
+				Description: "Date and time the sub-organization was created.",
+				Computed:    true,
+			},
+			"updated_time": schema.StringAttribute{
+				Description: "Date and time the sub-organization was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// </editor-fold>
+
+// Create <editor-fold desc="Create" defaultstate="collapsed">
+func (r SubOrgResource) Create(ctx context.Context, req CreateRequest, resp *CreateResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadCreate)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load configuration from plan.
+	var data SubOrgResourceModel
+	if diags.Append(req.Plan.Get(ctx, &data)) {
+		return
+	}
+
+	orgData := data.newOrgData()
+	apiRes, apiErr := client.CreateSubOrgWithResponse(ctx, v2.CreateSubOrgJSONRequestBody{
+		Type:             utils.Ptr(v2.CreateSubOrgRequestBodyTypeOrg),
+		Name:             orgData.Name,
+		Description:      orgData.Description,
+		Address1:         orgData.Address1,
+		Address2:         orgData.Address2,
+		City:             orgData.City,
+		State:            orgData.State,
+		Zipcode:          orgData.Zipcode,
+		Country:          orgData.Country,
+		Timezone:         orgData.Timezone,
+		ContactFirstName: orgData.ContactFirstName,
+		ContactLastName:  orgData.ContactLastName,
+		ContactEmail:     orgData.ContactEmail,
+		ContactPhone:     orgData.ContactPhone,
+		InheritLicenses:  data.InheritLicenses.ValueBoolPointer(),
+	})
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Handle error responses.
+	if diags.HandleError(apiRes.RequireStatus(200)) {
+		return
+	}
+
+	if data.updateState(diags, apiRes.JSON200) {
+		return
+	}
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &data))
+
+}
+
+// </editor-fold>
+
+// Read <editor-fold desc="Read" defaultstate="collapsed">
+func (r SubOrgResource) Read(ctx context.Context, req ReadRequest, resp *ReadResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadRead)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load the previous state.
+	var data SubOrgResourceModel
+	if diags.Append(req.State.Get(ctx, &data)) {
+		return
+	}
+
+	if data.Id.IsNull() {
+		diags.WithPath(path.Root("id")).AddError(
+			"Resource id is missing.")
+		return
+	}
+
+	// Perform the API request.
+	apiRes, apiErr := client.GetOrgWithResponse(ctx, data.Id.ValueString())
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Remove the resource if not found, otherwise handle error responses.
+	resErr := apiRes.RequireStatus(200)
+	if errors.Is(resErr, common.ErrNotFound) {
+		RemoveMissingResource(ctx, diags, &resp.State, "sub-organization", data.Id.ValueString())
+		return
+	}
+	if diags.HandleError(resErr) {
+		return
+	}
+
+	if data.updateState(diags, apiRes.JSON200) {
+		return
+	}
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &data))
+
+}
+
+// </editor-fold>
+
+// Update <editor-fold desc="Update" defaultstate="collapsed">
+func (r SubOrgResource) Update(ctx context.Context, req UpdateRequest, resp *UpdateResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadUpdate)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load configuration from plan.
+	var plan SubOrgResourceModel
+	if diags.Append(req.Plan.Get(ctx, &plan)) {
+		return
+	}
+
+	apiRes, apiErr := client.UpdateOrgWithResponse(ctx, plan.Id.ValueString(), plan.newOrgData())
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Handle error responses.
+	if diags.HandleError(apiRes.RequireStatus(200)) {
+		return
+	}
+
+	if plan.updateState(diags, apiRes.JSON200) {
+		return
+	}
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &plan))
+
+}
+
+// </editor-fold>
+
+// Delete <editor-fold desc="Delete" defaultstate="collapsed">
+func (r SubOrgResource) Delete(ctx context.Context, req DeleteRequest, resp *DeleteResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadDelete)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV2(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load the previous state.
+	var data SubOrgResourceModel
+	if diags.Append(req.State.Get(ctx, &data)) {
+		return
+	}
+
+	apiRes, apiErr := client.DeleteSubOrgWithResponse(ctx, data.Id.ValueString())
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Handle error responses, but consider it done if it's already gone.
+	resErr := apiRes.RequireStatus(200)
+	if !errors.Is(resErr, common.ErrNotFound) && diags.HandleError(resErr) {
+		return
+	}
+
+}
+
+// </editor-fold>
+
+func (m *SubOrgResourceModel) newOrgData() v2.OrgData {
+	orgData := v2.OrgData{
+		Type:             utils.Ptr(v2.OrgDataTypeOrg),
+		Name:             m.Name.ValueString(),
+		Description:      m.Description.ValueStringPointer(),
+		Address1:         m.Address1.ValueStringPointer(),
+		Address2:         m.Address2.ValueStringPointer(),
+		City:             m.City.ValueStringPointer(),
+		State:            m.State.ValueStringPointer(),
+		Zipcode:          m.Zipcode.ValueStringPointer(),
+		Country:          m.Country.ValueStringPointer(),
+		ContactFirstName: m.ContactFirstName.ValueStringPointer(),
+		ContactLastName:  m.ContactLastName.ValueStringPointer(),
+		ContactEmail:     m.ContactEmail.ValueStringPointer(),
+		ContactPhone:     m.ContactPhone.ValueStringPointer(),
+	}
+	// Left out when not configured, so the parent's time zone is used.
+	if !m.Timezone.IsUnknown() {
+		orgData.Timezone = m.Timezone.ValueStringPointer()
+	}
+	return orgData
+}
+
+func (m *SubOrgResourceModel) updateState(diags DiagsHandler, data *v2.Org) bool {
+	if data == nil {
+		diags.AddError("no organization response data provided")
+		return true
+	}
+
+	// Update the configured state so instabilities can be detected.
+	m.Id = types.StringPointerValue(data.Id)
+	m.Name = types.StringValue(data.Name)
+	m.Description = OptionalStringValue(data.Description)
+	m.Address1 = OptionalStringValue(data.Address1)
+	m.Address2 = OptionalStringValue(data.Address2)
+	m.City = OptionalStringValue(data.City)
+	m.State = OptionalStringValue(data.State)
+	m.Zipcode = OptionalStringValue(data.Zipcode)
+	m.Country = OptionalStringValue(data.Country)
+	m.Timezone = types.StringPointerValue(data.Timezone)
+	m.ContactFirstName = OptionalStringValue(data.ContactFirstName)
+	m.ContactLastName = OptionalStringValue(data.ContactLastName)
+	m.ContactEmail = OptionalStringValue(data.ContactEmail)
+	m.ContactPhone = OptionalStringValue(data.ContactPhone)
+
+	// Update derived values
+	m.ParentOrgId = types.StringPointerValue(data.ParentOrgId)
+	m.CreatedBy = types.StringPointerValue(data.CreatedBy)
+	m.UpdatedBy = types.StringPointerValue(data.UpdatedBy)
+	m.CreatedTime = types.StringPointerValue(data.CreateTime)
+	m.UpdatedTime = types.StringPointerValue(data.UpdateTime)
+
+	return diags.HasError()
+
+}
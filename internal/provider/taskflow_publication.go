@@ -0,0 +1,371 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/common"
+	"terraform-provider-idmc/internal/idmc/v3"
+	"terraform-provider-idmc/internal/utils"
+
+	. "github.com/hashicorp/terraform-plugin-framework/resource"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+var _ ResourceWithConfigure = &TaskflowPublicationResource{}
+
+// publishTimeout limits how long to wait for a (un)publish job to finish.
+const publishTimeout = 5 * time.Minute
+
+type TaskflowPublicationResource struct {
+	*IdmcProviderResource
+}
+
+func NewTaskflowPublicationResource() Resource {
+	return &TaskflowPublicationResource{
+		&IdmcProviderResource{},
+	}
+}
+
+type TaskflowPublicationResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	TaskflowId         types.String `tfsdk:"taskflow_id"`
+	RuntimeEnvironment types.String `tfsdk:"runtime_environment"`
+	RunAsUser          types.String `tfsdk:"run_as_user"`
+	Path               types.String `tfsdk:"path"`
+	ServiceUrl         types.String `tfsdk:"service_url"`
+}
+
+// Metadata <editor-fold desc="Metadata" defaultstate="collapsed">
+func (r TaskflowPublicationResource) Metadata(ctx context.Context, req MetadataRequest, resp *MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_taskflow_publication"
+}
+
+// </editor-fold>
+
+// Schema <editor-fold desc="Schema" defaultstate="collapsed">
+func (r TaskflowPublicationResource) Schema(ctx context.Context, req SchemaRequest, resp *SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "https://docs.informatica.com/integration-cloud/data-integration/current-version/rest-api-reference/platform-rest-api-version-3-resources/publishing-and-unpublishing-assets.html",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Same as the taskflow id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"taskflow_id": schema.StringAttribute{
+				Description: "Federated id of the taskflow to publish.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"runtime_environment": schema.StringAttribute{
+				Description: "ID of the runtime environment to publish the taskflow to, overriding the one it was designed with.",
+				Optional:    true,
+			},
+			"run_as_user": schema.StringAttribute{
+				Description: "Name of the user the published taskflow runs as, overriding the publishing user.",
+				Optional:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Path of the taskflow, such as 'Project/Folder/Taskflow'.",
+				Computed:    true,
+			},
+			"service_url": schema.StringAttribute{
+				Description: "The url the published taskflow can be invoked on. Only known once published, as republishing can change it.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// </editor-fold>
+
+// Create <editor-fold desc="Create" defaultstate="collapsed">
+func (r TaskflowPublicationResource) Create(ctx context.Context, req CreateRequest, resp *CreateResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadCreate)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV3(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load configuration from plan.
+	var data TaskflowPublicationResourceModel
+	if diags.Append(req.Plan.Get(ctx, &data)) {
+		return
+	}
+
+	if r.publishTaskflow(ctx, diags, client, &data) {
+		return
+	}
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &data))
+
+}
+
+// </editor-fold>
+
+// Read <editor-fold desc="Read" defaultstate="collapsed">
+func (r TaskflowPublicationResource) Read(ctx context.Context, req ReadRequest, resp *ReadResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadRead)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV3(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load the previous state.
+	var data TaskflowPublicationResourceModel
+	if diags.Append(req.State.Get(ctx, &data)) {
+		return
+	}
+
+	if data.TaskflowId.IsNull() {
+		diags.WithPath(path.Root("taskflow_id")).AddError(
+			"Resource taskflow id is missing.")
+		return
+	}
+
+	// There's no way to ask whether something is published, so the best that
+	// can be done is making sure the taskflow still exists.
+	apiRes, apiErr := client.LookupObjectsWithResponse(ctx, &v3.LookupObjectsParams{}, v3.LookupObjectsJSONRequestBody{
+		Objects: []v3.ObjectRef{{
+			Id: data.TaskflowId.ValueStringPointer(),
+		}},
+	})
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Remove the resource if not found, otherwise handle error responses.
+	resErr := apiRes.RequireStatus(200)
+	if errors.Is(resErr, common.ErrNotFound) {
+		RemoveMissingResource(ctx, diags, &resp.State, "taskflow", data.TaskflowId.ValueString())
+		return
+	}
+	if diags.HandleError(resErr) {
+		return
+	}
+
+	var taskflow *v3.ObjectInfo
+	for _, object := range utils.ValOr(utils.ValOr(apiRes.JSON200, v3.LookupResponseBody{}).Objects, nil) {
+		if utils.Val(object.Id) == data.TaskflowId.ValueString() {
+			taskflow = &object
+			break
+		}
+	}
+	if taskflow == nil {
+		RemoveMissingResource(ctx, diags, &resp.State, "taskflow", data.TaskflowId.ValueString())
+		return
+	}
+
+	data.Path = types.StringPointerValue(taskflow.Path)
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &data))
+
+}
+
+// </editor-fold>
+
+// Update <editor-fold desc="Update" defaultstate="collapsed">
+func (r TaskflowPublicationResource) Update(ctx context.Context, req UpdateRequest, resp *UpdateResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadUpdate)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV3(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load configuration from plan.
+	var plan TaskflowPublicationResourceModel
+	if diags.Append(req.Plan.Get(ctx, &plan)) {
+		return
+	}
+
+	// Publishing again replaces the previous publication's settings.
+	if r.publishTaskflow(ctx, diags, client, &plan) {
+		return
+	}
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &plan))
+
+}
+
+// </editor-fold>
+
+// Delete <editor-fold desc="Delete" defaultstate="collapsed">
+func (r TaskflowPublicationResource) Delete(ctx context.Context, req DeleteRequest, resp *DeleteResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadDelete)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV3(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load the previous state.
+	var data TaskflowPublicationResourceModel
+	if diags.Append(req.State.Get(ctx, &data)) {
+		return
+	}
+
+	apiRes, apiErr := client.UnpublishAssetsWithResponse(ctx, &v3.UnpublishAssetsParams{}, v3.UnpublishAssetsJSONRequestBody{
+		Objects: []v3.PublishObject{{
+			Id: data.TaskflowId.ValueStringPointer(),
+		}},
+	})
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Handle error responses, but consider it done if it's already gone.
+	resErr := apiRes.RequireStatus(200)
+	if errors.Is(resErr, common.ErrNotFound) {
+		return
+	}
+	if diags.HandleError(resErr) {
+		return
+	}
+
+	r.awaitPublishJob(ctx, diags, apiRes.JSON200, func(ctx context.Context, jobId string) (*v3.PublishJob, error) {
+		jobRes, jobErr := client.GetUnpublishJobWithResponse(ctx, jobId, &v3.GetUnpublishJobParams{})
+		if jobErr != nil {
+			return nil, jobErr
+		}
+		return jobRes.JSON200, jobRes.RequireStatus(200)
+	})
+
+}
+
+// </editor-fold>
+
+// publishTaskflow publishes the taskflow with the configured overrides and
+// waits for it to finish, updating the state with the results.
+func (r TaskflowPublicationResource) publishTaskflow(
+	ctx context.Context,
+	diags DiagsHandler,
+	client *v3.ClientWithResponses,
+	data *TaskflowPublicationResourceModel,
+) bool {
+
+	apiRes, apiErr := client.PublishAssetsWithResponse(ctx, &v3.PublishAssetsParams{}, v3.PublishAssetsJSONRequestBody{
+		Objects: []v3.PublishObject{{
+			Id:                   data.TaskflowId.ValueStringPointer(),
+			RuntimeEnvironmentId: data.RuntimeEnvironment.ValueStringPointer(),
+			RunAsUser:            data.RunAsUser.ValueStringPointer(),
+		}},
+	})
+	if diags.HandleError(apiErr) {
+		return true
+	}
+
+	// Handle error responses.
+	if diags.HandleError(apiRes.RequireStatus(200)) {
+		return true
+	}
+
+	job := r.awaitPublishJob(ctx, diags, apiRes.JSON200, func(ctx context.Context, jobId string) (*v3.PublishJob, error) {
+		jobRes, jobErr := client.GetPublishJobWithResponse(ctx, jobId, &v3.GetPublishJobParams{})
+		if jobErr != nil {
+			return nil, jobErr
+		}
+		return jobRes.JSON200, jobRes.RequireStatus(200)
+	})
+	if diags.HasError() {
+		return true
+	}
+
+	data.Id = data.TaskflowId
+	data.Path = types.StringNull()
+	data.ServiceUrl = types.StringNull()
+	for _, object := range utils.ValOr(job.Objects, nil) {
+		if utils.Val(object.Id) == data.TaskflowId.ValueString() {
+			data.Path = types.StringPointerValue(object.Path)
+			data.ServiceUrl = OptionalStringValue(object.ServiceUrl)
+		}
+	}
+
+	return diags.HasError()
+
+}
+
+// awaitPublishJob polls a (un)publish job until it has finished, returning the
+// final state of the job.
+func (r TaskflowPublicationResource) awaitPublishJob(
+	ctx context.Context,
+	diags DiagsHandler,
+	job *v3.PublishJob,
+	getJob func(ctx context.Context, jobId string) (*v3.PublishJob, error),
+) *v3.PublishJob {
+	if job == nil || job.Id == nil {
+		diags.AddError("no publish job response data provided")
+		return nil
+	}
+	jobId := *job.Id
+
+	pollCtx, cancel := context.WithTimeout(ctx, publishTimeout)
+	defer cancel()
+
+	pollErr := common.Poll(pollCtx, common.DefaultPollInterval, func(ctx context.Context) (bool, error) {
+		state := v3.PublishStatusStateNOTSTARTED
+		if job.Status != nil && job.Status.State != nil {
+			state = *job.Status.State
+		}
+
+		switch state {
+		case v3.PublishStatusStateSUCCESSFUL:
+			return true, nil
+		case v3.PublishStatusStateFAILED:
+			return false, publishJobError(job)
+		}
+
+		nextJob, err := getJob(ctx, jobId)
+		if err != nil {
+			return false, err
+		}
+		if nextJob != nil {
+			job = nextJob
+		}
+		return false, nil
+	})
+	if diags.HandleError(pollErr) {
+		return nil
+	}
+
+	return job
+
+}
+
+// publishJobError collects the reasons a publish job failed.
+func publishJobError(job *v3.PublishJob) error {
+	var errs []error
+	for _, object := range utils.ValOr(job.Objects, nil) {
+		if object.Status != nil && object.Status.Message != nil {
+			errs = append(errs, fmt.Errorf("%s: %s", utils.ValOr(object.Path, utils.Val(object.Id)), *object.Status.Message))
+		}
+	}
+	if job.Status != nil && job.Status.Message != nil {
+		errs = append(errs, errors.New(*job.Status.Message))
+	}
+	if len(errs) == 0 {
+		return fmt.Errorf("job %s failed without giving a reason", utils.Val(job.Id))
+	}
+	return fmt.Errorf("job %s failed: %w", utils.Val(job.Id), errors.Join(errs...))
+}
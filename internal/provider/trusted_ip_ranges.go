@@ -0,0 +1,468 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/common"
+	"terraform-provider-idmc/internal/idmc/v3"
+
+	. "github.com/hashicorp/terraform-plugin-framework/resource"
+	. "terraform-provider-idmc/internal/provider/utils"
+)
+
+const defaultCallerIpUrl = "https://checkip.amazonaws.com"
+
+var _ ResourceWithConfigure = &TrustedIpRangesResource{}
+var _ ResourceWithValidateConfig = &TrustedIpRangesResource{}
+
+type TrustedIpRangesResource struct {
+	*IdmcProviderResource
+}
+
+func NewTrustedIpRangesResource() Resource {
+	return &TrustedIpRangesResource{
+		&IdmcProviderResource{},
+	}
+}
+
+type TrustedIpRangesResourceModel struct {
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	Ranges       types.Set    `tfsdk:"ranges"`
+	AllowLockout types.Bool   `tfsdk:"allow_lockout"`
+	CallerIpUrl  types.String `tfsdk:"caller_ip_url"`
+}
+
+// Metadata <editor-fold desc="Metadata" defaultstate="collapsed">
+func (r TrustedIpRangesResource) Metadata(ctx context.Context, req MetadataRequest, resp *MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trusted_ip_ranges"
+}
+
+// </editor-fold>
+
+// Schema <editor-fold desc="Schema" defaultstate="collapsed">
+func (r TrustedIpRangesResource) Schema(ctx context.Context, req SchemaRequest, resp *SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The IP address ranges the organization the provider is logged in to can be accessed from. " +
+			"Only one of these should be declared per organization, and destroying it disables the restriction. " +
+			"Unless allow_lockout is set, every apply that enables the restriction first calls the service at caller_ip_url " +
+			"(by default the third-party service " + defaultCallerIpUrl + ", run by Amazon Web Services) " +
+			"to check the ranges include the address the provider is calling from. " +
+			"https://docs.informatica.com/integration-cloud/data-integration/current-version/rest-api-reference/platform-rest-api-version-3-resources/trusted-ip-ranges.html",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Whether access is restricted to the trusted IP address ranges.",
+				Required:    true,
+			},
+			"ranges": schema.SetAttribute{
+				Description: "The full list of trusted IP address ranges. " +
+					"Each is either a single address (`192.0.2.1`), a CIDR block (`192.0.2.0/24`), or an inclusive range (`192.0.2.1-192.0.2.99`).",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"allow_lockout": schema.BoolAttribute{
+				Description: "Apply the ranges even if they don't include the address the provider is calling from. " +
+					"Also skips the call to caller_ip_url. Defaults to false.",
+				Optional: true,
+			},
+			"caller_ip_url": schema.StringAttribute{
+				Description: "Address of a service that responds to a GET request with the plain text IP address the provider is calling from, " +
+					"used to prevent lockouts. Called on every apply that enables the restriction, unless allow_lockout is set. " +
+					"Defaults to '" + defaultCallerIpUrl + "', a third-party service run by Amazon Web Services; " +
+					"set this to a service of your own to avoid calling it.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+// </editor-fold>
+
+// ValidateConfig <editor-fold desc="ValidateConfig" defaultstate="collapsed">
+func (r TrustedIpRangesResource) ValidateConfig(ctx context.Context, req ValidateConfigRequest, resp *ValidateConfigResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadConfig)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	var config TrustedIpRangesResourceModel
+	if diags.Append(req.Config.Get(ctx, &config)) {
+		return
+	}
+
+	// Ranges can't be checked until they're known.
+	if config.Ranges.IsUnknown() || config.Ranges.IsNull() {
+		return
+	}
+
+	rangesDiags := diags.AtName("ranges").WithTitle("Invalid IP address range")
+	for _, element := range config.Ranges.Elements() {
+		text, ok := element.(types.String)
+		if !ok || text.IsUnknown() || text.IsNull() {
+			continue
+		}
+		if _, err := parseIpRange(text.ValueString()); err != nil {
+			rangesDiags.AtSetValue(element).AddError("%s", err)
+		}
+	}
+
+}
+
+// </editor-fold>
+
+// Create <editor-fold desc="Create" defaultstate="collapsed">
+func (r TrustedIpRangesResource) Create(ctx context.Context, req CreateRequest, resp *CreateResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadCreate)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	// Load configuration from plan.
+	var plan TrustedIpRangesResourceModel
+	if diags.Append(req.Plan.Get(ctx, &plan)) {
+		return
+	}
+
+	if r.apply(ctx, diags, &plan) {
+		return
+	}
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &plan))
+
+}
+
+// </editor-fold>
+
+// Read <editor-fold desc="Read" defaultstate="collapsed">
+func (r TrustedIpRangesResource) Read(ctx context.Context, req ReadRequest, resp *ReadResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadRead)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV3(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Load the previous state.
+	var data TrustedIpRangesResourceModel
+	if diags.Append(req.State.Get(ctx, &data)) {
+		return
+	}
+
+	apiRes, apiErr := client.GetTrustedIpRangesWithResponse(ctx, &v3.GetTrustedIpRangesParams{})
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	// Handle error responses.
+	if diags.HandleError(apiRes.RequireStatus(200)) {
+		return
+	}
+
+	if data.updateState(diags, apiRes.JSON200) {
+		return
+	}
+
+	// Save updated data into Terraform state.
+	diags.Append(resp.State.Set(ctx, &data))
+
+}
+
+// </editor-fold>
+
+// Update <editor-fold desc="Update" defaultstate="collapsed">
+func (r TrustedIpRangesResource) Update(ctx context.Context, req UpdateRequest, resp *UpdateResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadUpdate)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	// Load configuration from plan.
+	var plan TrustedIpRangesResourceModel
+	if diags.Append(req.Plan.Get(ctx, &plan)) {
+		return
+	}
+
+	if r.apply(ctx, diags, &plan) {
+		return
+	}
+
+	// Save result back to state.
+	diags.Append(resp.State.Set(ctx, &plan))
+
+}
+
+// </editor-fold>
+
+// Delete <editor-fold desc="Delete" defaultstate="collapsed">
+func (r TrustedIpRangesResource) Delete(ctx context.Context, req DeleteRequest, resp *DeleteResponse) {
+	diags := NewDiagsHandler(&resp.Diagnostics, MsgResourceBadDelete)
+	defer func() { diags.HandlePanic(recover()) }()
+
+	client := r.GetApiClientV3(diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Lifting the restriction can't lock anyone out, so needs no checks.
+	apiRes, apiErr := client.UpdateTrustedIpRangesWithResponse(ctx, &v3.UpdateTrustedIpRangesParams{}, v3.TrustedIpRanges{
+		Enabled:  false,
+		IpRanges: []v3.TrustedIpRange{},
+	})
+	if diags.HandleError(apiErr) {
+		return
+	}
+
+	diags.HandleError(apiRes.RequireStatus(200))
+
+}
+
+// </editor-fold>
+
+// apply replaces the organization's trusted ranges with those planned, so
+// long as doing so won't lock the provider out.
+func (r TrustedIpRangesResource) apply(ctx context.Context, diags DiagsHandler, plan *TrustedIpRangesResourceModel) bool {
+
+	client := r.GetApiClientV3(diags)
+	if diags.HasError() {
+		return true
+	}
+
+	rangesDiags := diags.AtName("ranges")
+	var ipRanges []ipRange
+	for _, element := range plan.Ranges.Elements() {
+		text, ok := element.(types.String)
+		if !ok {
+			rangesDiags.AtSetValue(element).AddError("Expected a string value.")
+			continue
+		}
+		parsed, err := parseIpRange(text.ValueString())
+		if err != nil {
+			rangesDiags.AtSetValue(element).AddError("%s", err)
+			continue
+		}
+		ipRanges = append(ipRanges, parsed)
+	}
+	if diags.HasError() {
+		return true
+	}
+
+	if plan.Enabled.ValueBool() && !plan.AllowLockout.ValueBool() {
+		if r.checkLockout(ctx, diags, plan, ipRanges) {
+			return true
+		}
+	}
+
+	body := v3.TrustedIpRanges{
+		Enabled:  plan.Enabled.ValueBool(),
+		IpRanges: make([]v3.TrustedIpRange, len(ipRanges)),
+	}
+	for index, ipRange := range ipRanges {
+		body.IpRanges[index] = v3.TrustedIpRange{
+			StartIp: ipRange.start.String(),
+			EndIp:   ipRange.end.String(),
+		}
+	}
+
+	apiRes, apiErr := client.UpdateTrustedIpRangesWithResponse(ctx, &v3.UpdateTrustedIpRangesParams{}, body)
+	if diags.HandleError(apiErr) || diags.HandleError(apiRes.RequireStatus(200)) {
+		return true
+	}
+
+	return plan.updateState(diags, apiRes.JSON200)
+}
+
+// checkLockout fails if the address the provider is calling from isn't within
+// any of the given ranges.
+func (r TrustedIpRangesResource) checkLockout(ctx context.Context, diags DiagsHandler, plan *TrustedIpRangesResourceModel, ipRanges []ipRange) bool {
+
+	httpClient := r.GetHttpClient(diags)
+	if diags.HasError() {
+		return true
+	}
+
+	callerIpUrl := plan.CallerIpUrl.ValueString()
+	if callerIpUrl == "" {
+		callerIpUrl = defaultCallerIpUrl
+	}
+
+	callerIp, err := lookupCallerIp(ctx, httpClient, callerIpUrl)
+	if err != nil {
+		diags.AtName("caller_ip_url").AddError(
+			"Unable to determine the address the provider is calling from, "+
+				"so can't ensure these ranges won't lock it out: %s", err)
+		return true
+	}
+
+	for _, ipRange := range ipRanges {
+		if ipRange.contains(callerIp) {
+			return false
+		}
+	}
+
+	diags.AtName("ranges").WithTitle("Trusted IP ranges would cause a lockout").AddError(
+		"None of the ranges include %s, which the provider is calling from. "+
+			"Add a range that does, or set allow_lockout if this is intended.", callerIp)
+	return true
+}
+
+func (m *TrustedIpRangesResourceModel) updateState(diags DiagsHandler, data *v3.TrustedIpRanges) bool {
+	if data == nil {
+		diags.AddError("no trusted IP range response data provided")
+		return true
+	}
+
+	// Ranges are kept as they were written when the api reports the same
+	// addresses, so a CIDR block doesn't show up as a start-end range.
+	written := map[ipRange]string{}
+	for _, element := range m.Ranges.Elements() {
+		if text, ok := element.(types.String); ok {
+			if parsed, err := parseIpRange(text.ValueString()); err == nil {
+				written[parsed] = text.ValueString()
+			}
+		}
+	}
+
+	rangesDiags := diags.AtName("ranges")
+	ranges := make([]attr.Value, 0, len(data.IpRanges))
+	for _, item := range data.IpRanges {
+		parsed, err := parseIpRange(item.StartIp + "-" + item.EndIp)
+		if err != nil {
+			rangesDiags.AddError("Unexpected range in API response: %s", err)
+			continue
+		}
+		if text, ok := written[parsed]; ok {
+			ranges = append(ranges, types.StringValue(text))
+		} else {
+			ranges = append(ranges, types.StringValue(parsed.String()))
+		}
+	}
+	m.Enabled = types.BoolValue(data.Enabled)
+	m.Ranges = rangesDiags.SetValue(types.StringType, ranges)
+
+	return diags.HasError()
+}
+
+// ipRange is an inclusive range of addresses within a single family.
+type ipRange struct {
+	start netip.Addr
+	end   netip.Addr
+}
+
+// parseIpRange accepts a single address, a CIDR block, or two addresses
+// separated by a hyphen.
+func parseIpRange(text string) (ipRange, error) {
+	text = strings.TrimSpace(text)
+
+	if startText, endText, ok := strings.Cut(text, "-"); ok {
+		start, err := parseIpRangeAddr(startText)
+		if err != nil {
+			return ipRange{}, err
+		}
+		end, err := parseIpRangeAddr(endText)
+		if err != nil {
+			return ipRange{}, err
+		}
+		if start.Is4() != end.Is4() {
+			return ipRange{}, fmt.Errorf("range %q mixes IPv4 and IPv6 addresses", text)
+		}
+		if end.Less(start) {
+			return ipRange{}, fmt.Errorf("range %q ends before it starts", text)
+		}
+		return ipRange{start, end}, nil
+	}
+
+	if strings.Contains(text, "/") {
+		prefix, err := netip.ParsePrefix(text)
+		if err != nil {
+			return ipRange{}, err
+		}
+		if prefix.Addr().Zone() != "" {
+			return ipRange{}, fmt.Errorf("address %q must not have a zone", text)
+		}
+		if masked := prefix.Masked(); masked != prefix {
+			return ipRange{}, fmt.Errorf("CIDR block %q has host bits set, did you mean %q?", text, masked)
+		}
+		return ipRange{prefix.Addr().Unmap(), lastPrefixAddr(prefix).Unmap()}, nil
+	}
+
+	addr, err := parseIpRangeAddr(text)
+	if err != nil {
+		return ipRange{}, err
+	}
+	return ipRange{addr, addr}, nil
+}
+
+func parseIpRangeAddr(text string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(text))
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	if addr.Zone() != "" {
+		return netip.Addr{}, fmt.Errorf("address %q must not have a zone", text)
+	}
+	return addr.Unmap(), nil
+}
+
+// lastPrefixAddr returns the highest address within a masked prefix.
+func lastPrefixAddr(prefix netip.Prefix) netip.Addr {
+	bytes := prefix.Addr().AsSlice()
+	for bit := prefix.Bits(); bit < len(bytes)*8; bit++ {
+		bytes[bit/8] |= 0x80 >> (bit % 8)
+	}
+	addr, _ := netip.AddrFromSlice(bytes)
+	return addr
+}
+
+func (r ipRange) contains(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if addr.Is4() != r.start.Is4() {
+		return false
+	}
+	return !addr.Less(r.start) && !r.end.Less(addr)
+}
+
+// String renders the range in its shortest form.
+func (r ipRange) String() string {
+	if r.start == r.end {
+		return r.start.String()
+	}
+	for bits := r.start.BitLen(); bits >= 0; bits-- {
+		prefix, err := r.start.Prefix(bits)
+		if err != nil || prefix.Addr() != r.start {
+			break
+		}
+		if lastPrefixAddr(prefix) == r.end {
+			return prefix.String()
+		}
+	}
+	return r.start.String() + "-" + r.end.String()
+}
+
+// lookupCallerIp asks an external service which address requests from this
+// host appear to come from.
+func lookupCallerIp(ctx context.Context, httpClient common.HttpRequestDoer, url string) (netip.Addr, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return netip.Addr{}, fmt.Errorf("unexpected response status %q", res.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 256))
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	return parseIpRangeAddr(string(body))
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseIpRange(t *testing.T) {
+	RegisterTestingT(t)
+
+	for text, expected := range map[string]string{
+		"192.0.2.1":                 "192.0.2.1",
+		"192.0.2.0/24":              "192.0.2.0/24",
+		"192.0.2.0-192.0.2.255":     "192.0.2.0/24",
+		"192.0.2.1-192.0.2.99":      "192.0.2.1-192.0.2.99",
+		" 192.0.2.1 - 192.0.2.1 ":   "192.0.2.1",
+		"::ffff:192.0.2.1":          "192.0.2.1",
+		"2001:db8::/32":             "2001:db8::/32",
+		"2001:db8::1-2001:db8::ff":  "2001:db8::1-2001:db8::ff",
+		"0.0.0.0/0":                 "0.0.0.0/0",
+		"192.0.2.128/25":            "192.0.2.128/25",
+		"192.0.2.128-192.0.2.255":   "192.0.2.128/25",
+		"192.0.2.130-192.0.2.255":   "192.0.2.130-192.0.2.255",
+		"10.0.0.0-10.255.255.255":   "10.0.0.0/8",
+		"2001:db8::-2001:db8::ffff": "2001:db8::/112",
+	} {
+		parsed, err := parseIpRange(text)
+		Expect(err).NotTo(HaveOccurred(), text)
+		Expect(parsed.String()).To(Equal(expected), text)
+	}
+
+	for _, text := range []string{
+		"",
+		"192.0.2",
+		"192.0.2.1/24",
+		"192.0.2.0/33",
+		"192.0.2.9-192.0.2.1",
+		"192.0.2.1-2001:db8::1",
+		"fe80::1%eth0",
+	} {
+		_, err := parseIpRange(text)
+		Expect(err).To(HaveOccurred(), text)
+	}
+
+}
+
+func TestIpRangeContains(t *testing.T) {
+	RegisterTestingT(t)
+
+	ipRange, err := parseIpRange("192.0.2.0/24")
+	Expect(err).NotTo(HaveOccurred())
+
+	Expect(ipRange.contains(netip.MustParseAddr("192.0.2.0"))).To(BeTrue())
+	Expect(ipRange.contains(netip.MustParseAddr("192.0.2.255"))).To(BeTrue())
+	Expect(ipRange.contains(netip.MustParseAddr("::ffff:192.0.2.7"))).To(BeTrue())
+	Expect(ipRange.contains(netip.MustParseAddr("192.0.3.0"))).To(BeFalse())
+	Expect(ipRange.contains(netip.MustParseAddr("2001:db8::1"))).To(BeFalse())
+
+}
+
+func TestLookupCallerIp(t *testing.T) {
+	RegisterTestingT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/broken" {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte("198.51.100.7\n"))
+	}))
+	defer server.Close()
+
+	callerIp, err := lookupCallerIp(context.Background(), server.Client(), server.URL)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(callerIp).To(Equal(netip.MustParseAddr("198.51.100.7")))
+
+	_, err = lookupCallerIp(context.Background(), server.Client(), server.URL+"/broken")
+	Expect(err).To(MatchError(ContainSubstring("502")))
+
+}
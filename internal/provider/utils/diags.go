@@ -1,11 +1,13 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-idmc/internal/idmc/common"
 
 	paths "github.com/hashicorp/terraform-plugin-framework/path"
 )
@@ -67,11 +69,19 @@ func (d DiagsHandler) Append(diags diag.Diagnostics) bool {
 // Handling ////////////////////////////////////////////////////////////////////
 
 func (d DiagsHandler) HandleError(err error) bool {
-	if err != nil {
-		d.AddError(err.Error())
+	if err == nil {
+		return d.diags.HasError()
+	}
+
+	// Api errors get the full breakdown of the response.
+	var apiErr *common.ApiError
+	if errors.As(err, &apiErr) {
+		d.WithTitle(MsgApiBadResponse).AddError("%s", apiErr.Detail())
 		return true
 	}
-	return d.diags.HasError()
+
+	d.AddError("%s", err.Error())
+	return true
 }
 
 func (d DiagsHandler) HandlePanic(panicData any) {
@@ -1,14 +1,104 @@
 package utils
 
 import (
+	"context"
 	"fmt"
+	"sync"
+
 	"terraform-provider-idmc/internal/idmc"
+	"terraform-provider-idmc/internal/idmc/common"
 	"terraform-provider-idmc/internal/idmc/v2"
 	"terraform-provider-idmc/internal/idmc/v3"
 )
 
 type IdmcProviderData struct {
 	Api *idmc.IdmcApi
+
+	// HttpClient makes requests outside the IDMC api, with the same transport
+	// settings as the api client.
+	HttpClient common.HttpRequestDoer
+
+	// Login starts a brand-new session using the provider credentials, which
+	// is independent of the one used by the provider itself.
+	Login func(ctx context.Context) (*IdmcSession, error)
+	// Logout ends a session, after which its id can no longer be used.
+	Logout func(ctx context.Context, session IdmcSession) error
+
+	// privileges caches the full privilege list, as it only changes with the
+	// organization's licenses, and every role plan needs it.
+	privileges     []v3.RolePrivilegeItem
+	privilegesLock sync.Mutex
+}
+
+// IdmcSession identifies an authenticated IDMC session.
+type IdmcSession struct {
+	BaseApiUrl string `json:"base_api_url"`
+	SessionId  string `json:"session_id"`
+}
+
+func (r *IdmcProviderData) NewSession(ctx context.Context, diags DiagsHandler) *IdmcSession {
+	if r == nil || r.Login == nil {
+		diags.AddError("The provider has not been configured to start new sessions.")
+		return nil
+	}
+	session, err := r.Login(ctx)
+	if diags.HandleError(err) {
+		return nil
+	}
+	return session
+}
+
+func (r *IdmcProviderData) EndSession(ctx context.Context, diags DiagsHandler, session IdmcSession) {
+	if r == nil || r.Logout == nil {
+		diags.AddError("The provider has not been configured to end sessions.")
+		return
+	}
+	diags.HandleError(r.Logout(ctx, session))
+}
+
+func (r *IdmcProviderData) GetHttpClient(diags DiagsHandler) common.HttpRequestDoer {
+	if r == nil || r.HttpClient == nil {
+		diags.AddError("The provider has not properly initialised the http client.")
+		return nil
+	}
+	return r.HttpClient
+}
+
+// GetPrivileges lists every privilege available to the organization, only
+// asking the api the first time.
+func (r *IdmcProviderData) GetPrivileges(ctx context.Context, diags DiagsHandler) []v3.RolePrivilegeItem {
+	client := r.GetApiClientV3(diags)
+	if diags.HasError() {
+		return nil
+	}
+
+	r.privilegesLock.Lock()
+	defer r.privilegesLock.Unlock()
+	if r.privileges != nil {
+		return r.privileges
+	}
+
+	pages := common.NewPaginator(common.DefaultPageLimit, func(ctx context.Context, limit int32, skip int32) ([]v3.RolePrivilegeItem, error) {
+		apiRes, apiErr := client.ListPrivilegesWithResponse(ctx, &v3.ListPrivilegesParams{
+			Limit: &limit,
+			Skip:  &skip,
+		})
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		if apiRes.JSON200 == nil {
+			return nil, apiRes.RequireStatus(200)
+		}
+		return *apiRes.JSON200, apiRes.RequireStatus(200)
+	})
+
+	privileges, err := pages.All(ctx)
+	if diags.HandleError(err) {
+		return nil
+	}
+
+	r.privileges = privileges
+	return privileges
 }
 
 func (r *IdmcProviderData) GetApi(diags DiagsHandler) *idmc.IdmcApi {
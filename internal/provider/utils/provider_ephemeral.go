@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"context"
+
+	. "github.com/hashicorp/terraform-plugin-framework/ephemeral"
+)
+
+const (
+	MsgEphemeralResourceBadConfig = "Unable to configure ephemeral resource"
+	MsgEphemeralResourceBadOpen   = "Unable to open ephemeral resource"
+	MsgEphemeralResourceBadClose  = "Unable to close ephemeral resource"
+)
+
+type IdmcProviderEphemeralResource struct {
+	*IdmcProviderData
+}
+
+func (r *IdmcProviderEphemeralResource) Configure(ctx context.Context, req ConfigureRequest, res *ConfigureResponse) {
+	diags := NewDiagsHandler(&res.Diagnostics, MsgEphemeralResourceBadConfig)
+	r.IdmcProviderData = GetProviderData(diags, req.ProviderData)
+	if r.IdmcProviderData == nil && req.ProviderData != nil {
+		diags.AddError("GetProviderData returned nil, but the original value isn't.")
+	}
+}
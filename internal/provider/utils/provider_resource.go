@@ -3,15 +3,19 @@ package utils
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+
 	. "github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
 const (
 	MsgResourceBadConfig = "Unable to configure resource"
-	MsgResourceBadUpdate = "Unable to read resource"
+	MsgResourceBadPlan   = "Unable to plan resource"
+	MsgResourceBadUpdate = "Unable to update resource"
 	MsgResourceBadDelete = "Unable to delete resource"
 	MsgResourceBadRead   = "Unable to read resource"
 	MsgResourceBadCreate = "Unable to create resource"
+	MsgResourceMissing   = "Resource no longer exists"
 )
 
 type IdmcProviderResource struct {
@@ -25,3 +29,15 @@ func (r *IdmcProviderResource) Configure(ctx context.Context, req ConfigureReque
 		diags.AddError("GetProviderData returned nil, but the original value isn't.")
 	}
 }
+
+// RemoveMissingResource drops a resource that has been deleted outside of
+// terraform from the state, warning about it so that the subsequent plan to
+// re-create it isn't a surprise.
+func RemoveMissingResource(ctx context.Context, diags DiagsHandler, state *tfsdk.State, kind string, id string) {
+	diags.WithTitle(MsgResourceMissing).AddWarning(
+		"The %s '%s' could not be found, so it has been removed from state. "+
+			"It was most likely deleted outside of terraform.",
+		kind, id,
+	)
+	state.RemoveResource(ctx)
+}
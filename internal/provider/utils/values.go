@@ -0,0 +1,15 @@
+package utils
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// OptionalStringValue converts an optional api string, treating empty strings
+// as unset, since IDMC tends to return "" for fields that were never given a
+// value rather than omitting them.
+func OptionalStringValue(value *string) types.String {
+	if value == nil || *value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
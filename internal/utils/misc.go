@@ -4,8 +4,14 @@ func Ptr[T any](val T) *T {
 	return &val
 }
 
+// Val dereferences a pointer, giving the zero value for nil, as the api leaves
+// out most fields it has no value for.
 func Val[T any](ptr *T) T {
-	return *ptr
+	if ptr != nil {
+		return *ptr
+	}
+	var zero T
+	return zero
 }
 
 func ValOr[T any](ptr *T, or T) T {
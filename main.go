@@ -4,7 +4,9 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
 	"terraform-provider-idmc/internal/provider"
@@ -23,6 +25,11 @@ var (
 	// https://goreleaser.com/cookbooks/using-main.version/
 )
 
+// sessionCloseTimeout limits how long shutdown can be held up by logging out.
+// Terraform (through go-plugin) force-kills the provider 2s after asking it to
+// stop, so this stays under that to give up cleanly rather than be cut off.
+const sessionCloseTimeout = 1500 * time.Millisecond
+
 func main() {
 	var debug bool
 
@@ -34,7 +41,17 @@ func main() {
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	idmcProvider := provider.NewIdmcProvider(version)
+	err := providerserver.Serve(context.Background(), func() fwprovider.Provider {
+		return idmcProvider
+	}, opts)
+
+	// Don't leave the provider's session lingering once terraform is done.
+	closeCtx, closeCancel := context.WithTimeout(context.Background(), sessionCloseTimeout)
+	if closeErr := idmcProvider.Close(closeCtx); closeErr != nil {
+		log.Printf("[WARN] Unable to log out of IDMC: %s", closeErr)
+	}
+	closeCancel()
 
 	if err != nil {
 		log.Fatal(err.Error())